@@ -29,10 +29,15 @@ func main() {
 		cfg.Timeout,
 		cfg.IdleTimeout,
 		cfg.PostgresConn,
+		cfg.TenderReopen,
+		cfg.TenderCloseInterval,
+		cfg.StrictBudget,
+		cfg.KeepDecisions,
+		cfg.Auth,
 	)
 
 	// Run server.
-	go httpApplication.Router.MustRun()
+	go httpApplication.MustRun()
 
 	// Graceful shutdown.
 	stop := make(chan os.Signal, 1)
@@ -41,8 +46,7 @@ func main() {
 	<-stop
 
 	// Stop application.
-	httpApplication.Router.Stop()
-	httpApplication.Storage.Postgres.Stop()
+	httpApplication.Stop()
 	log.Info("Gracefully stopped")
 }
 
@@ -1,17 +1,23 @@
 package app
 
 import (
+	"errors"
 	"log/slog"
+	"os"
 	"time"
 
 	storage "tender/internal/app/postgres"
 	router "tender/internal/app/router"
+	scheduler "tender/internal/app/scheduler"
+	"tender/internal/config"
+	"tender/internal/lib/auth"
 	"tender/internal/lib/logger/sl"
 )
 
 type App struct {
-	Router  *router.App
-	Storage *storage.Storage
+	Router    *router.App
+	Storage   *storage.Storage
+	Scheduler *scheduler.Scheduler
 }
 
 func New(
@@ -21,6 +27,11 @@ func New(
 	Timeout time.Duration,
 	idleTimeout time.Duration,
 	postgresURL string,
+	tenderReopen bool,
+	tenderCloseInterval time.Duration,
+	strictBudget bool,
+	keepDecisions bool,
+	authCfg config.Auth,
 ) *App {
 	storage, err := storage.New(postgresURL)
 	if err != nil {
@@ -28,6 +39,15 @@ func New(
 		panic(err)
 	}
 
+	authenticator, err := newAuthenticator(authCfg)
+	if err != nil {
+		log.Error("failed to create authenticator", sl.Err(err))
+		panic(err)
+	}
+	if authenticator == nil {
+		log.Warn("authentication is disabled, caller is taken from query")
+	}
+
 	router := router.New(
 		log,
 		addr,
@@ -38,10 +58,75 @@ func New(
 		storage.Postgres,
 		storage.Postgres,
 		storage.Postgres,
+		storage.Postgres,
+		storage.Postgres,
+		storage.Postgres,
+		storage.Postgres,
+		tenderReopen,
+		strictBudget,
+		keepDecisions,
+		authenticator,
+	)
+
+	// Close overdue tenders in background.
+	scheduler := scheduler.New(
+		log,
+		tenderCloseInterval,
+		router.Tender(),
 	)
 
 	return &App{
-		Router:  router,
-		Storage: storage,
+		Router:    router,
+		Storage:   storage,
+		Scheduler: scheduler,
 	}
 }
+
+// MustRun starts background jobs and http server.
+// Panics if server fails.
+func (a *App) MustRun() {
+	a.Scheduler.Start()
+	a.Router.MustRun()
+}
+
+// Stop stops http server, background jobs and storage.
+func (a *App) Stop() error {
+	err := a.Router.Stop()
+	a.Scheduler.Stop()
+	a.Storage.Postgres.Stop()
+	return err
+}
+
+// newAuthenticator creates authenticator from config.
+// Nil is returned if authentication is disabled.
+func newAuthenticator(cfg config.Auth) (auth.Authenticator, error) {
+	if !cfg.AuthEnabled {
+		return nil, nil
+	}
+
+	var chain auth.Chain
+
+	if len(cfg.APITokens) != 0 {
+		chain = append(chain, auth.Tokens(cfg.APITokens))
+	}
+	if cfg.JWTSecret != "" {
+		chain = append(chain, auth.NewHMAC([]byte(cfg.JWTSecret)))
+	}
+	if cfg.JWTPublicKeyPath != "" {
+		key, err := os.ReadFile(cfg.JWTPublicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		jwt, err := auth.NewRSA(key)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, jwt)
+	}
+
+	if len(chain) == 0 {
+		return nil, errors.New("authentication is enabled but no keys or tokens are set")
+	}
+
+	return chain, nil
+}
@@ -9,10 +9,20 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	bidCtr "tender/internal/controller/bid"
+	employeeCtr "tender/internal/controller/employee"
+	evaluationCtr "tender/internal/controller/evaluation"
+	orgCtr "tender/internal/controller/organization"
 	pingCtr "tender/internal/controller/ping"
+	questionCtr "tender/internal/controller/question"
 	tenderCtr "tender/internal/controller/tender"
+	"tender/internal/lib/auth"
+	"tender/internal/models"
 
 	bidSrv "tender/internal/service/bid"
+	employeeSrv "tender/internal/service/employee"
+	evaluationSrv "tender/internal/service/evaluation"
+	orgSrv "tender/internal/service/organization"
+	questionSrv "tender/internal/service/question"
 	rollbackSrv "tender/internal/service/rollback"
 	tenderSrv "tender/internal/service/tender"
 	userSrv "tender/internal/service/user"
@@ -22,6 +32,7 @@ type App struct {
 	log      *slog.Logger
 	addr     string
 	fiberApp *fiber.App
+	tender   *tenderSrv.Tender
 }
 
 func New(
@@ -34,6 +45,14 @@ func New(
 	tenderStorage tenderSrv.TenderStorage,
 	bidStorage bidSrv.BidStorage,
 	rollbackStorage rollbackSrv.RollbackStorage,
+	orgStorage orgSrv.OrgStorage,
+	employeeStorage employeeSrv.EmployeeStorage,
+	evaluationStorage evaluationSrv.EvaluationStorage,
+	questionStorage questionSrv.QuestionStorage,
+	tenderReopen bool,
+	strictBudget bool,
+	keepDecisions bool,
+	authenticator auth.Authenticator,
 ) *App {
 	// Initialize services.
 	user := userSrv.New(
@@ -49,6 +68,7 @@ func New(
 		user,
 		rollback,
 		tenderStorage,
+		models.NewTenderTransitions(tenderReopen),
 	)
 	bid := bidSrv.New(
 		log,
@@ -56,6 +76,30 @@ func New(
 		tender,
 		rollback,
 		bidStorage,
+		strictBudget,
+		keepDecisions,
+	)
+	org := orgSrv.New(
+		log,
+		user,
+		orgStorage,
+	)
+	employee := employeeSrv.New(
+		log,
+		user,
+		employeeStorage,
+	)
+	evaluation := evaluationSrv.New(
+		log,
+		user,
+		tender,
+		evaluationStorage,
+	)
+	question := questionSrv.New(
+		log,
+		user,
+		tender,
+		questionStorage,
 	)
 
 	// Initialize fiber router.
@@ -64,10 +108,18 @@ func New(
 		JSONDecoder: decode,
 	})
 
+	// Resolve caller for protected controllers.
+	fiberApp.Use([]string{"/api/tenders", "/api/bids", "/api/organizations", "/api/employees"}, authenticate(authenticator))
+
 	// Mount controllers.
 	fiberApp.Mount("/api/ping", pingCtr.New(Timeout))
 	fiberApp.Mount("/api/tenders", tenderCtr.New(Timeout, tender))
 	fiberApp.Mount("/api/bids", bidCtr.New(Timeout, bid))
+	fiberApp.Mount("/api/organizations", orgCtr.New(Timeout, org))
+	fiberApp.Mount("/api/employees", employeeCtr.New(Timeout, employee))
+	// Evaluation routes belong to both tenders and bids.
+	fiberApp.Mount("/api", evaluationCtr.New(Timeout, evaluation))
+	fiberApp.Mount("/api/tenders", questionCtr.New(Timeout, question))
 
 	// Handler for openapi specification.
 	fiberApp.Get("/api/openapi", func(c *fiber.Ctx) error {
@@ -78,9 +130,15 @@ func New(
 		log:      log,
 		addr:     addr,
 		fiberApp: fiberApp,
+		tender:   tender,
 	}
 }
 
+// Tender returns tender service used by controllers.
+func (a *App) Tender() *tenderSrv.Tender {
+	return a.tender
+}
+
 func (a *App) MustRun() {
 	if err := a.Run(); err != nil {
 		panic(err)
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tender/internal/lib/auth"
+	valid "tender/internal/lib/validate"
+	"tender/internal/models"
+)
+
+// authenticate returns middleware storing caller's username in user context.
+// Caller is resolved by bearer token from Authorization header.
+// If authenticator is nil, username is trusted from query parameters.
+func authenticate(authenticator auth.Authenticator) fiber.Handler {
+	if authenticator == nil {
+		return trustQuery
+	}
+
+	return func(c *fiber.Ctx) error {
+		token, ok := strings.CutPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+		if !ok || token == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("missing bearer token"))
+		}
+
+		username, err := authenticator.Authenticate(token)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
+		}
+
+		c.SetUserContext(auth.WithUsername(c.UserContext(), username))
+
+		return c.Next()
+	}
+}
+
+// trustQuery takes caller's username from query parameters
+// as it was passed before authentication was introduced.
+func trustQuery(c *fiber.Ctx) error {
+	username := c.Query("username", c.Query("requesterUsername"))
+	if username == "" {
+		return c.Next()
+	}
+
+	if err := valid.Validate(username, "username", 100); err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
+	}
+
+	c.SetUserContext(auth.WithUsername(c.UserContext(), username))
+
+	return c.Next()
+}
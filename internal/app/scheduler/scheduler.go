@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"tender/internal/lib/logger/sl"
+)
+
+// TenderCloser closes tenders whose deadline has passed.
+type TenderCloser interface {
+	CloseOverdue(ctx context.Context) (int, error)
+}
+
+// Scheduler periodically closes overdue tenders.
+type Scheduler struct {
+	log      *slog.Logger
+	interval time.Duration
+	closer   TenderCloser
+
+	// Guards cancel and stopped, as Start and Stop
+	// are called from different goroutines.
+	mu      sync.Mutex
+	cancel  context.CancelFunc
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+func New(
+	log *slog.Logger,
+	interval time.Duration,
+	closer TenderCloser,
+) *Scheduler {
+	return &Scheduler{
+		log:      log,
+		interval: interval,
+		closer:   closer,
+	}
+}
+
+// Start runs scheduler in background.
+// Overdue tenders are closed immediately and then once per interval.
+// Scheduler is not started if interval is not positive,
+// if it is already running or was stopped.
+func (s *Scheduler) Start() {
+	if s.interval <= 0 {
+		s.log.Warn("scheduler is not started", slog.Duration("interval", s.interval))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopped || s.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			s.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops scheduler and waits for running job to finish.
+// Scheduler can't be started after it was stopped.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	s.stopped = true
+	cancel := s.cancel
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+	cancel()
+	s.wg.Wait()
+}
+
+// run closes overdue tenders once.
+func (s *Scheduler) run(ctx context.Context) {
+	const op = "Scheduler.run"
+
+	log := s.log.With(slog.String("op", op))
+
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	closed, err := s.closer.CloseOverdue(ctx)
+	if err != nil {
+		log.Error("failed to close overdue tenders", sl.Err(err))
+		return
+	}
+	if closed > 0 {
+		log.Info("overdue tenders closed", slog.Int("count", closed))
+	}
+}
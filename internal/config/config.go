@@ -10,6 +10,8 @@ type Config struct {
 	PrettyLogger bool `env:"PRETTY_LOGGER" env-default:"false"`
 	HTTPServer
 	Postgres
+	Policy
+	Auth
 }
 
 type HTTPServer struct {
@@ -29,6 +31,20 @@ type Postgres struct {
 	PostgresDataBase string `env:"POSTGRES_DATABASE" env-required:"true"`
 }
 
+type Policy struct {
+	TenderReopen        bool          `env:"TENDER_REOPEN" env-default:"false"`
+	TenderCloseInterval time.Duration `env:"TENDER_CLOSE_INTERVAL" env-default:"1m"`
+	StrictBudget        bool          `env:"STRICT_BUDGET" env-default:"false"`
+	KeepDecisions       bool          `env:"KEEP_DECISIONS" env-default:"false"`
+}
+
+type Auth struct {
+	AuthEnabled      bool              `env:"AUTH_ENABLED" env-default:"true"`
+	JWTSecret        string            `env:"JWT_SECRET"`
+	JWTPublicKeyPath string            `env:"JWT_PUBLIC_KEY_PATH"`
+	APITokens        map[string]string `env:"API_TOKENS"`
+}
+
 // MustLoad load config from environment
 // variables. Panic if error occures.
 func MustLoad() *Config {
@@ -38,5 +54,9 @@ func MustLoad() *Config {
 		panic("cannot read environment: " + err.Error())
 	}
 
+	if cfg.TenderCloseInterval <= 0 {
+		panic("TENDER_CLOSE_INTERVAL must be positive")
+	}
+
 	return &cfg
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"tender/internal/controller/response"
 	valid "tender/internal/lib/validate"
 	"tender/internal/models"
 	"tender/internal/service"
@@ -30,23 +31,32 @@ func New(
 
 	// Group 07/bids/decision
 	app.Put("/:bidId/submit_decision", ctr.decision)
+	app.Get("/:bidId/decisions", ctr.decisions)
 
 	// Group 08/bids/list
 	app.Get("/:tenderId/list", ctr.list)
 	app.Get("/my", ctr.my)
+	app.Get("/:tenderId/compare", ctr.compare)
 
 	// Group 09/bids/status
 	app.Get("/:bidId/status", ctr.status)
 	app.Put("/:bidId/status", ctr.statusUpd)
+	app.Post("/:bidId/withdraw", ctr.withdraw)
+	app.Post("/:bidId/resubmit", ctr.resubmit)
 
 	// Group 10/bids/version
 	app.Patch("/:bidId/edit", ctr.edit)
 	app.Put("/:bidId/rollback/:version", ctr.rollback)
+	app.Get("/:bidId/versions", ctr.versions)
+	app.Get("/:bidId/diff", ctr.diff)
 
 	// Group 11/bids/reviews
 	app.Get("/:tenderId/reviews", ctr.reviews)
 	app.Put("/:bidId/feedback", ctr.feedback)
 
+	// Registered last so that static routes like /my take precedence.
+	app.Get("/:bidId", ctr.get)
+
 	return app
 }
 
@@ -55,21 +65,29 @@ type bidController struct {
 	bid        Bid
 }
 
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name Bid
 type Bid interface {
-	New(context.Context, models.BidNew) (models.BidOut, error)
-	SubmitDecision(ctx context.Context, username string, bidId uuid.UUID, decision models.DecisionType) (models.BidOut, error)
-	List(ctx context.Context, username string, tenderId uuid.UUID, limit, offset int32) ([]models.BidOut, error)
-	My(ctx context.Context, username string, limit, offset int32) ([]models.BidOut, error)
-	Status(ctx context.Context, username string, bidId uuid.UUID) (models.BidStatus, error)
-	SetStatus(ctx context.Context, username string, bidId uuid.UUID, status models.BidStatus) (models.BidOut, error)
-	Edit(ctx context.Context, username string, bidId uuid.UUID, patch models.BidPatch) (models.BidOut, error)
-	Rollback(ctx context.Context, username string, bidId uuid.UUID, version int32) (models.BidOut, error)
-	Reviews(ctx context.Context, requester, author string, tenderId uuid.UUID, limit, offset int32) ([]models.ReviewOut, error)
-	Feedback(ctx context.Context, username string, bidId uuid.UUID, feedback string) (models.BidOut, error)
+	New(ctx context.Context, bidNew models.BidNew) (models.BidOut, error)
+	SubmitDecision(ctx context.Context, bidId uuid.UUID, decision models.DecisionType, comment string) (models.BidDecisionOut, error)
+	Decisions(ctx context.Context, bidId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.DecisionOut, *models.Cursor, error)
+	List(ctx context.Context, tenderId uuid.UUID, query string, limit, offset int32, after *models.Cursor) ([]models.BidOut, *models.Cursor, error)
+	My(ctx context.Context, limit, offset int32, after *models.Cursor) ([]models.BidOut, *models.Cursor, error)
+	Compare(ctx context.Context, tenderId uuid.UUID) ([]models.BidOut, error)
+	Get(ctx context.Context, bidId uuid.UUID) (models.BidOut, error)
+	Status(ctx context.Context, bidId uuid.UUID) (models.BidStatus, error)
+	SetStatus(ctx context.Context, bidId uuid.UUID, status models.BidStatus) (models.BidOut, error)
+	Withdraw(ctx context.Context, bidId uuid.UUID, withdrawalNew models.WithdrawalNew) (models.BidWithdrawalOut, error)
+	Resubmit(ctx context.Context, bidId uuid.UUID) (models.BidWithdrawalOut, error)
+	Edit(ctx context.Context, bidId uuid.UUID, version int32, patch models.BidPatch) (models.BidOut, error)
+	Rollback(ctx context.Context, bidId uuid.UUID, version int32) (models.BidOut, error)
+	Versions(ctx context.Context, bidId uuid.UUID, limit, offset int32) ([]models.BidOut, error)
+	Diff(ctx context.Context, bidId uuid.UUID, from, to int32) (models.Diff, error)
+	Reviews(ctx context.Context, author string, tenderId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.ReviewOut, *models.Cursor, error)
+	Feedback(ctx context.Context, bidId uuid.UUID, feedback string) (models.BidOut, error)
 }
 
 func (b *bidController) new(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
 	var bidNew models.BidNew
@@ -87,9 +105,36 @@ func (b *bidController) new(c *fiber.Ctx) error {
 
 	res, err := b.bid.New(ctx, bidNew)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
+		if errors.Is(err, service.ErrAuthorNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("author not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrDeadlinePassed) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender deadline has passed"))
+		}
+		if errors.Is(err, service.ErrOverBudget) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid price exceeds tender budget"))
+		}
+		if errors.Is(err, service.ErrCurrencyMismatch) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid currency differs from tender budget currency"))
+		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
@@ -97,14 +142,9 @@ func (b *bidController) new(c *fiber.Ctx) error {
 }
 
 func (b *bidController) decision(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	bidId, err := uuid.Parse(c.Params("bidId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
@@ -119,8 +159,18 @@ func (b *bidController) decision(c *fiber.Ctx) error {
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
-	res, err := b.bid.SubmitDecision(ctx, username, bidId, desicion)
+	comment := c.Query("comment")
+	if comment != "" {
+		if err := valid.Validate(comment, "comment", 1000); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp(err.Error()))
+		}
+	}
+
+	res, err := b.bid.SubmitDecision(ctx, bidId, desicion, comment)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -128,7 +178,22 @@ func (b *bidController) decision(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action for user"))
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		if errors.Is(err, service.ErrConflictOfInterest) {
+			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("user can't decide on own bid"))
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrBidNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid is not published"))
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("invalid status transition"))
+		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid was modified concurrently"))
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -136,16 +201,57 @@ func (b *bidController) decision(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(res)
 }
 
-func (b *bidController) list(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+func (b *bidController) decisions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
 	limit := int32(c.QueryInt("limit", 5))
 	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
+
+	bidId, err := uuid.Parse(c.Params("bidId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
+	}
+
+	after, err := models.ParseCursor(c.Query("cursor"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid cursor"))
+	}
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
+	res, next, err := b.bid.Decisions(ctx, bidId, limit, offset, after)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.NewPage(res, next))
+}
+
+func (b *bidController) list(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
+	defer cancel()
+
+	limit := int32(c.QueryInt("limit", 5))
+	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
 	}
 
 	tenderId, err := uuid.Parse(c.Params("tenderId"))
@@ -153,8 +259,16 @@ func (b *bidController) list(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
 	}
 
-	res, err := b.bid.List(ctx, username, tenderId, limit, offset)
+	after, err := models.ParseCursor(c.Query("cursor"))
 	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid cursor"))
+	}
+
+	res, next, err := b.bid.List(ctx, tenderId, c.Query("q"), limit, offset, after)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -165,7 +279,33 @@ func (b *bidController) list(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action for user"))
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.NewPage(res, next))
+}
+
+func (b *bidController) compare(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	res, err := b.bid.Compare(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -174,49 +314,85 @@ func (b *bidController) list(c *fiber.Ctx) error {
 }
 
 func (b *bidController) my(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
 	limit := int32(c.QueryInt("limit", 5))
 	offset := int32(c.QueryInt("offset", 0))
-	username := c.Query("username")
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
 
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
+	after, err := models.ParseCursor(c.Query("cursor"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid cursor"))
 	}
 
-	res, err := b.bid.My(ctx, username, limit, offset)
+	res, next, err := b.bid.My(ctx, limit, offset, after)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(res)
+	return c.Status(fiber.StatusOK).JSON(models.NewPage(res, next))
 }
 
-func (b *bidController) status(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+func (b *bidController) get(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
+	bidId, err := uuid.Parse(c.Params("bidId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
+	}
+
+	res, err := b.bid.Get(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+func (b *bidController) status(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
+	defer cancel()
+
 	bidId, err := uuid.Parse(c.Params("bidId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
 	}
 
-	res, err := b.bid.Status(ctx, username, bidId)
+	res, err := b.bid.Status(ctx, bidId)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action for user"))
+			return response.Forbidden(c, err, "unallowed action for user")
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -225,14 +401,9 @@ func (b *bidController) status(c *fiber.Ctx) error {
 }
 
 func (b *bidController) statusUpd(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	bidId, err := uuid.Parse(c.Params("bidId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
@@ -246,8 +417,11 @@ func (b *bidController) statusUpd(c *fiber.Ctx) error {
 		}
 	}
 
-	res, err := b.bid.SetStatus(ctx, username, bidId, status)
+	res, err := b.bid.SetStatus(ctx, bidId, status)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -255,7 +429,19 @@ func (b *bidController) statusUpd(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action for user"))
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("invalid status transition"))
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrDeadlinePassed) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender deadline has passed"))
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -263,20 +449,109 @@ func (b *bidController) statusUpd(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(res)
 }
 
-func (b *bidController) edit(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+func (b *bidController) withdraw(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
+	bidId, err := uuid.Parse(c.Params("bidId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
+	}
+
+	var withdrawalNew models.WithdrawalNew
+
+	if err := c.BodyParser(&withdrawalNew); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
 	}
 
+	res, err := b.bid.Withdraw(ctx, bidId, withdrawalNew)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("invalid status transition"))
+		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid was modified concurrently"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+func (b *bidController) resubmit(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
+	defer cancel()
+
 	bidId, err := uuid.Parse(c.Params("bidId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
 	}
 
+	res, err := b.bid.Resubmit(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("invalid status transition"))
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrDeadlinePassed) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender deadline has passed"))
+		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid was modified concurrently"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+func (b *bidController) edit(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
+	defer cancel()
+
+	bidId, err := uuid.Parse(c.Params("bidId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
+	}
+
+	version, err := valid.Version(c.Get(fiber.HeaderIfMatch), c.Query("version"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp(err.Error()))
+	}
+
 	var patch models.BidPatch
 
 	if err := c.BodyParser(&patch); err != nil {
@@ -287,8 +562,19 @@ func (b *bidController) edit(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
 	}
 
-	res, err := b.bid.Edit(ctx, username, bidId, patch)
+	res, err := b.bid.Edit(ctx, bidId, version, patch)
 	if err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			// Precondition given by client failed, otherwise bid was modified concurrently.
+			status := fiber.StatusConflict
+			if version != 0 {
+				status = fiber.StatusPreconditionFailed
+			}
+			return c.Status(status).JSON(models.VersionConflictResp("version conflict", res.Version))
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -296,7 +582,25 @@ func (b *bidController) edit(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("bid not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action for user"))
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid can't be changed in its status"))
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrDeadlinePassed) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender deadline has passed"))
+		}
+		if errors.Is(err, service.ErrOverBudget) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid price exceeds tender budget"))
+		}
+		if errors.Is(err, service.ErrCurrencyMismatch) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid currency differs from tender budget currency"))
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -305,14 +609,9 @@ func (b *bidController) edit(c *fiber.Ctx) error {
 }
 
 func (b *bidController) rollback(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	bidId, err := uuid.Parse(c.Params("bidId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
@@ -323,13 +622,16 @@ func (b *bidController) rollback(c *fiber.Ctx) error {
 		c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid version"))
 	}
 
-	res, err := b.bid.Rollback(ctx, username, bidId, int32(versionInt64))
+	res, err := b.bid.Rollback(ctx, bidId, int32(versionInt64))
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action"))
+			return response.Forbidden(c, err, "unallowed action")
 		}
 		if errors.Is(err, service.ErrBidNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
@@ -337,36 +639,105 @@ func (b *bidController) rollback(c *fiber.Ctx) error {
 		if errors.Is(err, service.ErrVersionNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("version not found"))
 		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid was modified concurrently"))
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid can't be changed in its status"))
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrDeadlinePassed) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender deadline has passed"))
+		}
+		if errors.Is(err, service.ErrOverBudget) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid price exceeds tender budget"))
+		}
+		if errors.Is(err, service.ErrCurrencyMismatch) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid currency differs from tender budget currency"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+func (b *bidController) versions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
+	defer cancel()
+
+	limit := int32(c.QueryInt("limit", 5))
+	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
+	if offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid offset"))
+	}
+
+	bidId, err := uuid.Parse(c.Params("bidId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
+	}
+
+	res, err := b.bid.Versions(ctx, bidId, limit, offset)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
+	if res == nil {
+		res = []models.BidOut{}
+	}
+
 	return c.Status(fiber.StatusOK).JSON(res)
 }
 
 func (b *bidController) reviews(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
 	limit := int32(c.QueryInt("limit", 5))
 	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
 
 	authorUsername := c.Query("authorUsername")
 	if err := valid.Validate(authorUsername, "author username", 100); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp(err.Error()))
 	}
 
-	requesterUsername := c.Query("requesterUsername")
-	if err := valid.Validate(requesterUsername, "requester username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	tenderId, err := uuid.Parse(c.Params("tenderId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
 	}
 
-	res, err := b.bid.Reviews(ctx, requesterUsername, authorUsername, tenderId, limit, offset)
+	after, err := models.ParseCursor(c.Query("cursor"))
 	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid cursor"))
+	}
+
+	res, next, err := b.bid.Reviews(ctx, authorUsername, tenderId, limit, offset, after)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -374,7 +745,7 @@ func (b *bidController) reviews(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("author not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action"))
+			return response.Forbidden(c, err, "unallowed action")
 		}
 		if errors.Is(err, service.ErrTenderNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
@@ -385,11 +756,11 @@ func (b *bidController) reviews(c *fiber.Ctx) error {
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(res)
+	return c.Status(fiber.StatusOK).JSON(models.NewPage(res, next))
 }
 
 func (b *bidController) feedback(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), b.ErrTimeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
 	defer cancel()
 
 	bidFeedback := c.Query("bidFeedback")
@@ -397,23 +768,21 @@ func (b *bidController) feedback(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp(err.Error()))
 
 	}
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	bidId, err := uuid.Parse(c.Params("bidId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
 	}
 
-	res, err := b.bid.Feedback(ctx, username, bidId, bidFeedback)
+	res, err := b.bid.Feedback(ctx, bidId, bidFeedback)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action"))
+			return response.Forbidden(c, err, "unallowed action")
 		}
 		if errors.Is(err, service.ErrBidNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
@@ -423,3 +792,44 @@ func (b *bidController) feedback(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(res)
 }
+
+func (b *bidController) diff(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), b.ErrTimeout)
+	defer cancel()
+
+	bidId, err := uuid.Parse(c.Params("bidId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
+	}
+
+	from, err := strconv.ParseInt(c.Query("from"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid from version"))
+	}
+	to, err := strconv.ParseInt(c.Query("to"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid to version"))
+	}
+
+	res, err := b.bid.Diff(ctx, bidId, int32(from), int32(to))
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
+		if errors.Is(err, service.ErrVersionNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("version not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,298 @@
+package controller
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"tender/internal/controller/bid/mocks"
+	"tender/internal/models"
+	"tender/internal/service"
+)
+
+var (
+	BID_UUID    = uuid.MustParse("0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c")
+	TENDER_UUID = uuid.MustParse("3fa85f64-5717-4562-b3fc-2c963f66afa6")
+	ORG_UUID    = uuid.MustParse("002f9d2b-cd76-4921-8e53-21dbde75f993")
+)
+
+func Test_bidController_list(t *testing.T) {
+	type listRes struct {
+		query         string
+		limit, offset int32
+		after         *models.Cursor
+		bids          []models.BidOut
+		next          *models.Cursor
+		err           error
+	}
+	type resp struct {
+		body string
+		code int
+	}
+	createdAt := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	next := &models.Cursor{Rank: 0.5, Name: "road repair", Id: BID_UUID}
+	tests := []struct {
+		name    string
+		query   string
+		listRes *listRes
+		resp    resp
+	}{
+		{
+			name:  "search",
+			query: "q=road+repair&limit=1",
+			listRes: &listRes{"road repair", 1, 0, nil, []models.BidOut{{
+				BidBase: models.BidBase{
+					TenderId:   TENDER_UUID,
+					Name:       "road repair",
+					AuthorType: models.Organization,
+					AuthorId:   ORG_UUID,
+				},
+				Id:        BID_UUID,
+				Version:   1,
+				Status:    models.BidPublished,
+				CreatedAt: createdAt,
+			}}, next, nil},
+			resp: resp{`{"items": [{
+				"id": "0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c",
+				"tenderId": "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+				"name": "road repair",
+				"description": "",
+				"authorType": "Organization",
+				"authorId": "002f9d2b-cd76-4921-8e53-21dbde75f993",
+				"version": 1,
+				"status": "Published",
+				"createdAt": "2006-01-02T15:04:05Z"
+			}], "nextCursor": "` + next.String() + `"}`, 200},
+		},
+		{
+			name:    "next page",
+			query:   "q=road&cursor=" + next.String(),
+			listRes: &listRes{"road", 5, 0, next, nil, nil, nil},
+			resp:    resp{`{"items": []}`, 200},
+		},
+		{
+			name:  "invalid limit",
+			query: "limit=0",
+			resp:  resp{`{"reason":"invalid limit"}`, 400},
+		},
+		{
+			name:  "invalid cursor",
+			query: "cursor=invalid",
+			resp:  resp{`{"reason":"invalid cursor"}`, 400},
+		},
+		{
+			name:    "tender not found",
+			listRes: &listRes{"", 5, 0, nil, nil, nil, service.ErrTenderNotFound},
+			resp:    resp{`{"reason":"tender not found"}`, 404},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid := mocks.NewBid(t)
+
+			if tt.listRes != nil {
+				bid.
+					On("List", mock.Anything, TENDER_UUID, tt.listRes.query, tt.listRes.limit, tt.listRes.offset, tt.listRes.after).
+					Return(tt.listRes.bids, tt.listRes.next, tt.listRes.err)
+			}
+
+			bc := &bidController{
+				ErrTimeout: time.Hour,
+				bid:        bid,
+			}
+
+			app := fiber.New()
+			app.Get("/:tenderId/list", bc.list)
+
+			req := httptest.NewRequest("GET", "/"+TENDER_UUID.String()+"/list?"+tt.query, nil)
+
+			resp, err := app.Test(req, int(bc.ErrTimeout.Seconds()))
+			require.NoError(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tt.resp.body, string(respBody))
+			assert.Equal(t, tt.resp.code, resp.StatusCode)
+		})
+	}
+}
+
+func Test_bidController_withdraw(t *testing.T) {
+	type withdrawRes struct {
+		bid models.BidWithdrawalOut
+		err error
+	}
+	type resp struct {
+		body string
+		code int
+	}
+	withdrawnAt := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name        string
+		body        string
+		withdrawRes *withdrawRes
+		resp        resp
+	}{
+		{
+			name: "main line",
+			body: `{"reason": "price changed"}`,
+			withdrawRes: &withdrawRes{models.BidWithdrawalOut{
+				BidOut: models.BidOut{
+					BidBase: models.BidBase{
+						TenderId:   TENDER_UUID,
+						Name:       "road repair",
+						AuthorType: models.Organization,
+						AuthorId:   ORG_UUID,
+					},
+					Id:        BID_UUID,
+					Version:   2,
+					Status:    models.BidWithdrawn,
+					CreatedAt: withdrawnAt,
+				},
+				Withdrawal: &models.WithdrawalOut{
+					Id:          BID_UUID,
+					Reason:      "price changed",
+					WithdrawnAt: withdrawnAt,
+				},
+			}, nil},
+			resp: resp{`{
+				"id": "0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c",
+				"tenderId": "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+				"name": "road repair",
+				"description": "",
+				"authorType": "Organization",
+				"authorId": "002f9d2b-cd76-4921-8e53-21dbde75f993",
+				"version": 2,
+				"status": "Withdrawn",
+				"createdAt": "2006-01-02T15:04:05Z",
+				"withdrawal": {
+					"id": "0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c",
+					"reason": "price changed",
+					"withdrawnAt": "2006-01-02T15:04:05Z"
+				}
+			}`, 200},
+		},
+		{
+			name: "empty reason",
+			body: `{"reason": ""}`,
+			resp: resp{`{"reason":"reason must not be empty"}`, 400},
+		},
+		{
+			name:        "invalid transition",
+			body:        `{"reason": "price changed"}`,
+			withdrawRes: &withdrawRes{models.BidWithdrawalOut{}, service.ErrInvalidTransition},
+			resp:        resp{`{"reason":"invalid status transition"}`, 409},
+		},
+		{
+			name:        "modified concurrently",
+			body:        `{"reason": "price changed"}`,
+			withdrawRes: &withdrawRes{models.BidWithdrawalOut{}, service.ErrVersionConflict},
+			resp:        resp{`{"reason":"bid was modified concurrently"}`, 409},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid := mocks.NewBid(t)
+
+			if tt.withdrawRes != nil {
+				bid.
+					On("Withdraw", mock.Anything, BID_UUID, models.WithdrawalNew{Reason: "price changed"}).
+					Return(tt.withdrawRes.bid, tt.withdrawRes.err)
+			}
+
+			bc := &bidController{
+				ErrTimeout: time.Hour,
+				bid:        bid,
+			}
+
+			app := fiber.New()
+			app.Post("/:bidId/withdraw", bc.withdraw)
+
+			req := httptest.NewRequest("POST", "/"+BID_UUID.String()+"/withdraw", bytes.NewBuffer([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req, int(bc.ErrTimeout.Seconds()))
+			require.NoError(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tt.resp.body, string(respBody))
+			assert.Equal(t, tt.resp.code, resp.StatusCode)
+		})
+	}
+}
+
+func Test_bidController_versions(t *testing.T) {
+	type versionsRes struct {
+		limit, offset int32
+		bids          []models.BidOut
+		err           error
+	}
+	type resp struct {
+		body string
+		code int
+	}
+	tests := []struct {
+		name        string
+		query       string
+		versionsRes *versionsRes
+		resp        resp
+	}{
+		{
+			name:        "main line",
+			query:       "limit=2&offset=1",
+			versionsRes: &versionsRes{2, 1, []models.BidOut{}, nil},
+			resp:        resp{`[]`, 200},
+		},
+		{
+			name:  "invalid limit",
+			query: "limit=-1",
+			resp:  resp{`{"reason":"invalid limit"}`, 400},
+		},
+		{
+			name:  "invalid offset",
+			query: "offset=-1",
+			resp:  resp{`{"reason":"invalid offset"}`, 400},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid := mocks.NewBid(t)
+
+			if tt.versionsRes != nil {
+				bid.
+					On("Versions", mock.Anything, BID_UUID, tt.versionsRes.limit, tt.versionsRes.offset).
+					Return(tt.versionsRes.bids, tt.versionsRes.err)
+			}
+
+			bc := &bidController{
+				ErrTimeout: time.Hour,
+				bid:        bid,
+			}
+
+			app := fiber.New()
+			app.Get("/:bidId/versions", bc.versions)
+
+			req := httptest.NewRequest("GET", "/"+BID_UUID.String()+"/versions?"+tt.query, nil)
+
+			resp, err := app.Test(req, int(bc.ErrTimeout.Seconds()))
+			require.NoError(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tt.resp.body, string(respBody))
+			assert.Equal(t, tt.resp.code, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"tender/internal/controller/response"
+	"tender/internal/models"
+	"tender/internal/service"
+)
+
+func New(
+	Timeout time.Duration,
+	employee Employee,
+) *fiber.App {
+	ctr := employeeController{
+		Timeout:  Timeout,
+		employee: employee,
+	}
+
+	app := fiber.New()
+
+	app.Post("/new", ctr.new)
+	app.Get("/", ctr.list)
+	app.Get("/:employeeId", ctr.get)
+	app.Patch("/:employeeId/edit", ctr.edit)
+	app.Delete("/:employeeId", ctr.delete)
+
+	return app
+}
+
+type employeeController struct {
+	Timeout  time.Duration
+	employee Employee
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name Employee
+type Employee interface {
+	New(ctx context.Context, employeeNew models.EmployeeNew) (models.EmployeeOut, error)
+	Get(ctx context.Context, employeeId uuid.UUID) (models.EmployeeOut, error)
+	List(ctx context.Context, limit, offset int32) ([]models.EmployeeOut, error)
+	Edit(ctx context.Context, employeeId uuid.UUID, patch models.EmployeePatch) (models.EmployeeOut, error)
+	Delete(ctx context.Context, employeeId uuid.UUID) error
+}
+
+// new registers new employee.
+func (e *employeeController) new(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	var employeeNew models.EmployeeNew
+
+	if err := c.BodyParser(&employeeNew); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := e.employee.New(ctx, employeeNew)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrUsernameTaken) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("username is taken"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// list returns employees.
+func (e *employeeController) list(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	limit := int32(c.QueryInt("limit", 5))
+	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
+	if offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid offset"))
+	}
+
+	res, err := e.employee.List(ctx, limit, offset)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	if res == nil {
+		res = []models.EmployeeOut{}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// get returns employee.
+func (e *employeeController) get(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	employeeId, err := uuid.Parse(c.Params("employeeId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid employee id"))
+	}
+
+	res, err := e.employee.Get(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrEmployeeNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("employee not found"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// edit updates employee's profile.
+func (e *employeeController) edit(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	employeeId, err := uuid.Parse(c.Params("employeeId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid employee id"))
+	}
+
+	var patch models.EmployeePatch
+
+	if err := c.BodyParser(&patch); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := e.employee.Edit(ctx, employeeId, patch)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrEmployeeNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("employee not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// delete deletes employee.
+func (e *employeeController) delete(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	employeeId, err := uuid.Parse(c.Params("employeeId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid employee id"))
+	}
+
+	if err := e.employee.Delete(ctx, employeeId); err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrEmployeeNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("employee not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		if errors.Is(err, service.ErrLastAdmin) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("organization must keep at least one admin"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,200 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"tender/internal/controller/response"
+	"tender/internal/models"
+	"tender/internal/service"
+)
+
+func New(
+	Timeout time.Duration,
+	evaluation Evaluation,
+) *fiber.App {
+	ctr := evaluationController{
+		Timeout:    Timeout,
+		evaluation: evaluation,
+	}
+
+	app := fiber.New()
+
+	app.Get("/tenders/:tenderId/criteria", ctr.criteria)
+	app.Put("/tenders/:tenderId/criteria", ctr.setCriteria)
+	app.Get("/tenders/:tenderId/ranking", ctr.ranking)
+	app.Put("/bids/:bidId/scores", ctr.score)
+
+	return app
+}
+
+type evaluationController struct {
+	Timeout    time.Duration
+	evaluation Evaluation
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name Evaluation
+type Evaluation interface {
+	SetCriteria(ctx context.Context, tenderId uuid.UUID, criteriaNew models.CriteriaNew) ([]models.CriterionOut, error)
+	Criteria(ctx context.Context, tenderId uuid.UUID) ([]models.CriterionOut, error)
+	Score(ctx context.Context, bidId uuid.UUID, scoresNew models.ScoresNew) error
+	Ranking(ctx context.Context, tenderId uuid.UUID) ([]models.BidRankOut, error)
+}
+
+// criteria returns tender's evaluation criteria.
+func (e *evaluationController) criteria(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	res, err := e.evaluation.Criteria(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "not enough privileges")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// setCriteria replaces tender's evaluation criteria.
+func (e *evaluationController) setCriteria(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	var criteriaNew models.CriteriaNew
+
+	if err := c.BodyParser(&criteriaNew); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := e.evaluation.SetCriteria(ctx, tenderId, criteriaNew)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "not enough privileges")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// ranking returns tender's published bids ranked by scores.
+func (e *evaluationController) ranking(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	res, err := e.evaluation.Ranking(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "not enough privileges")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// score saves caller's per-criterion scores of bid.
+func (e *evaluationController) score(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), e.Timeout)
+	defer cancel()
+
+	bidId, err := uuid.Parse(c.Params("bidId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid bid id"))
+	}
+
+	var scoresNew models.ScoresNew
+
+	if err := c.BodyParser(&scoresNew); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	if err := e.evaluation.Score(ctx, bidId, scoresNew); err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrBidNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("bid not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrCriterionNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("criterion not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "not enough privileges")
+		}
+		if errors.Is(err, service.ErrConflictOfInterest) {
+			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("user can't score own bid"))
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrBidNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("bid is not published"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,395 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"tender/internal/controller/response"
+	"tender/internal/models"
+	"tender/internal/service"
+)
+
+func New(
+	Timeout time.Duration,
+	org Organization,
+) *fiber.App {
+	ctr := orgController{
+		Timeout: Timeout,
+		org:     org,
+	}
+
+	app := fiber.New()
+
+	app.Post("/new", ctr.new)
+	app.Get("/", ctr.list)
+	app.Get("/:orgId", ctr.get)
+	app.Patch("/:orgId/edit", ctr.edit)
+	app.Delete("/:orgId", ctr.delete)
+
+	// Membership management.
+	app.Get("/:orgId/responsibles", ctr.responsibles)
+	app.Post("/:orgId/responsibles", ctr.addResponsible)
+	app.Patch("/:orgId/responsibles/:userId", ctr.setResponsibleRole)
+	app.Delete("/:orgId/responsibles/:userId", ctr.removeResponsible)
+
+	return app
+}
+
+type orgController struct {
+	Timeout time.Duration
+	org     Organization
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name Organization
+type Organization interface {
+	New(ctx context.Context, orgNew models.OrgNew) (models.OrgOut, error)
+	Get(ctx context.Context, orgId uuid.UUID) (models.OrgOut, error)
+	List(ctx context.Context, limit, offset int32) ([]models.OrgOut, error)
+	Edit(ctx context.Context, orgId uuid.UUID, patch models.OrgPatch) (models.OrgOut, error)
+	Delete(ctx context.Context, orgId uuid.UUID) error
+	Responsibles(ctx context.Context, orgId uuid.UUID, limit, offset int32) ([]models.ResponsibleOut, error)
+	AddResponsible(ctx context.Context, orgId uuid.UUID, responsible models.ResponsibleNew) (models.ResponsibleOut, error)
+	SetResponsibleRole(ctx context.Context, orgId, userId uuid.UUID, patch models.ResponsiblePatch) (models.ResponsibleOut, error)
+	RemoveResponsible(ctx context.Context, orgId, userId uuid.UUID) error
+}
+
+// new creates new organization.
+func (o *orgController) new(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	var orgNew models.OrgNew
+
+	if err := c.BodyParser(&orgNew); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := o.org.New(ctx, orgNew)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// list returns organizations.
+func (o *orgController) list(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	limit := int32(c.QueryInt("limit", 5))
+	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
+	if offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid offset"))
+	}
+
+	res, err := o.org.List(ctx, limit, offset)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	if res == nil {
+		res = []models.OrgOut{}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// get returns organization.
+func (o *orgController) get(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	orgId, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid organization id"))
+	}
+
+	res, err := o.org.Get(ctx, orgId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// edit updates organization.
+func (o *orgController) edit(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	orgId, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid organization id"))
+	}
+
+	var patch models.OrgPatch
+
+	if err := c.BodyParser(&patch); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := o.org.Edit(ctx, orgId, patch)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// delete deletes organization.
+func (o *orgController) delete(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	orgId, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid organization id"))
+	}
+
+	if err := o.org.Delete(ctx, orgId); err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// responsibles returns organization's responsible employees.
+func (o *orgController) responsibles(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	limit := int32(c.QueryInt("limit", 5))
+	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
+	if offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid offset"))
+	}
+
+	orgId, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid organization id"))
+	}
+
+	res, err := o.org.Responsibles(ctx, orgId, limit, offset)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	if res == nil {
+		res = []models.ResponsibleOut{}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// addResponsible makes employee responsible for organization.
+func (o *orgController) addResponsible(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	orgId, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid organization id"))
+	}
+
+	var responsible models.ResponsibleNew
+
+	if err := c.BodyParser(&responsible); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := o.org.AddResponsible(ctx, orgId, responsible)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrEmployeeNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("employee not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		if errors.Is(err, service.ErrAlreadyResponsible) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("user is already responsible"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// setResponsibleRole changes role of organization's responsible employee.
+func (o *orgController) setResponsibleRole(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	orgId, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid organization id"))
+	}
+
+	userId, err := uuid.Parse(c.Params("userId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid user id"))
+	}
+
+	var patch models.ResponsiblePatch
+
+	if err := c.BodyParser(&patch); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := o.org.SetResponsibleRole(ctx, orgId, userId, patch)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		if errors.Is(err, service.ErrResponsibleNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("responsible not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		if errors.Is(err, service.ErrLastAdmin) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("organization must keep at least one admin"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// removeResponsible removes employee from organization's responsibles.
+func (o *orgController) removeResponsible(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), o.Timeout)
+	defer cancel()
+
+	orgId, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid organization id"))
+	}
+
+	userId, err := uuid.Parse(c.Params("userId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid user id"))
+	}
+
+	if err := o.org.RemoveResponsible(ctx, orgId, userId); err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrOrganizationNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("organization not found"))
+		}
+		if errors.Is(err, service.ErrResponsibleNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("responsible not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		if errors.Is(err, service.ErrLastAdmin) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("organization must keep at least one admin"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
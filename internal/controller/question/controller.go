@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"tender/internal/controller/response"
+	"tender/internal/models"
+	"tender/internal/service"
+)
+
+func New(
+	Timeout time.Duration,
+	question Question,
+) *fiber.App {
+	ctr := questionController{
+		Timeout:  Timeout,
+		question: question,
+	}
+
+	app := fiber.New()
+
+	app.Get("/:tenderId/questions", ctr.questions)
+	app.Post("/:tenderId/questions", ctr.ask)
+	app.Put("/:tenderId/questions/:questionId/answer", ctr.answer)
+
+	return app
+}
+
+type questionController struct {
+	Timeout  time.Duration
+	question Question
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name Question
+type Question interface {
+	Ask(ctx context.Context, tenderId uuid.UUID, questionNew models.QuestionNew) (models.QuestionOut, error)
+	Questions(ctx context.Context, tenderId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.QuestionOut, *models.Cursor, error)
+	Answer(ctx context.Context, tenderId, questionId uuid.UUID, answerNew models.AnswerNew) (models.QuestionOut, error)
+}
+
+// questions returns tender's questions visible to user.
+func (q *questionController) questions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), q.Timeout)
+	defer cancel()
+
+	limit := int32(c.QueryInt("limit", 5))
+	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	after, err := models.ParseCursor(c.Query("cursor"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid cursor"))
+	}
+
+	res, next, err := q.question.Questions(ctx, tenderId, limit, offset, after)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "not enough privileges")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(models.NewPage(res, next))
+}
+
+// ask saves user's question on tender.
+func (q *questionController) ask(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), q.Timeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	var questionNew models.QuestionNew
+
+	if err := c.BodyParser(&questionNew); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := q.question.Ask(ctx, tenderId, questionNew)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotPublished) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("tender is not published"))
+		}
+		if errors.Is(err, service.ErrOwnTender) {
+			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("user can't ask on own organization's tender"))
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// answer saves responsible's answer on question.
+func (q *questionController) answer(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), q.Timeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	questionId, err := uuid.Parse(c.Params("questionId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid question id"))
+	}
+
+	var answerNew models.AnswerNew
+
+	if err := c.BodyParser(&answerNew); err != nil {
+		var parseErr *models.Error
+		if errors.As(err, &parseErr) {
+			return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
+	}
+
+	res, err := q.question.Answer(ctx, tenderId, questionId, answerNew)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrQuestionNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("question not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "not enough privileges")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
@@ -0,0 +1,306 @@
+package controller
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"tender/internal/controller/question/mocks"
+	"tender/internal/models"
+	"tender/internal/service"
+)
+
+var (
+	TENDER_UUID   = uuid.MustParse("3fa85f64-5717-4562-b3fc-2c963f66afa6")
+	QUESTION_UUID = uuid.MustParse("0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c")
+)
+
+func Test_questionController_ask(t *testing.T) {
+	type req struct {
+		tenderId string
+		body     string
+	}
+	type askRes struct {
+		question models.QuestionOut
+		err      error
+	}
+	type resp struct {
+		body string
+		code int
+	}
+	askedAt := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		req    req
+		askRes *askRes
+		resp   resp
+	}{
+		{
+			name: "main line",
+			req:  req{TENDER_UUID.String(), `{"text": "is delivery included?"}`},
+			askRes: &askRes{models.QuestionOut{
+				Id:        QUESTION_UUID,
+				Username:  "supplier",
+				Text:      "is delivery included?",
+				CreatedAt: askedAt,
+			}, nil},
+			resp: resp{`{
+				"id": "0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c",
+				"username": "supplier",
+				"text": "is delivery included?",
+				"createdAt": "2006-01-02T15:04:05Z"
+			}`, 200},
+		},
+		{
+			name: "empty text",
+			req:  req{TENDER_UUID.String(), `{"text": ""}`},
+			resp: resp{`{"reason":"text must not be empty"}`, 400},
+		},
+		{
+			name: "invalid tender id",
+			req:  req{"invalid", `{"text": "is delivery included?"}`},
+			resp: resp{`{"reason":"invalid tender id"}`, 404},
+		},
+		{
+			name:   "tender not published",
+			req:    req{TENDER_UUID.String(), `{"text": "is delivery included?"}`},
+			askRes: &askRes{models.QuestionOut{}, service.ErrTenderNotPublished},
+			resp:   resp{`{"reason":"tender is not published"}`, 409},
+		},
+		{
+			name:   "own organization's tender",
+			req:    req{TENDER_UUID.String(), `{"text": "is delivery included?"}`},
+			askRes: &askRes{models.QuestionOut{}, service.ErrOwnTender},
+			resp:   resp{`{"reason":"user can't ask on own organization's tender"}`, 403},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			question := mocks.NewQuestion(t)
+
+			if tt.askRes != nil {
+				question.
+					On("Ask", mock.Anything, TENDER_UUID, models.QuestionNew{Text: "is delivery included?"}).
+					Return(tt.askRes.question, tt.askRes.err)
+			}
+
+			qc := &questionController{
+				Timeout:  time.Hour,
+				question: question,
+			}
+
+			app := fiber.New()
+			app.Post("/:tenderId/questions", qc.ask)
+
+			req := httptest.NewRequest("POST", "/"+tt.req.tenderId+"/questions", bytes.NewBuffer([]byte(tt.req.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req, int(qc.Timeout.Seconds()))
+			require.NoError(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tt.resp.body, string(respBody))
+			assert.Equal(t, tt.resp.code, resp.StatusCode)
+		})
+	}
+}
+
+func Test_questionController_questions(t *testing.T) {
+	type req struct {
+		query string
+	}
+	type questionsRes struct {
+		limit     int32
+		offset    int32
+		questions []models.QuestionOut
+		err       error
+	}
+	type resp struct {
+		body string
+		code int
+	}
+	askedAt := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name         string
+		req          req
+		questionsRes *questionsRes
+		resp         resp
+	}{
+		{
+			name: "main line",
+			req:  req{"limit=2&offset=1"},
+			questionsRes: &questionsRes{2, 1, []models.QuestionOut{{
+				Id:        QUESTION_UUID,
+				Text:      "is delivery included?",
+				CreatedAt: askedAt,
+				Answer: &models.AnswerOut{
+					Username:  "employee",
+					Text:      "yes",
+					Public:    true,
+					CreatedAt: askedAt,
+				},
+			}}, nil},
+			resp: resp{`{"items": [{
+				"id": "0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c",
+				"text": "is delivery included?",
+				"createdAt": "2006-01-02T15:04:05Z",
+				"answer": {
+					"username": "employee",
+					"text": "yes",
+					"public": true,
+					"createdAt": "2006-01-02T15:04:05Z"
+				}
+			}]}`, 200},
+		},
+		{
+			name:         "no questions",
+			questionsRes: &questionsRes{5, 0, nil, nil},
+			resp:         resp{`{"items": []}`, 200},
+		},
+		{
+			name: "invalid limit",
+			req:  req{"limit=0"},
+			resp: resp{`{"reason":"invalid limit"}`, 400},
+		},
+		{
+			name:         "tender not found",
+			questionsRes: &questionsRes{5, 0, nil, service.ErrTenderNotFound},
+			resp:         resp{`{"reason":"tender not found"}`, 404},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			question := mocks.NewQuestion(t)
+
+			if tt.questionsRes != nil {
+				question.
+					On("Questions", mock.Anything, TENDER_UUID, tt.questionsRes.limit, tt.questionsRes.offset, (*models.Cursor)(nil)).
+					Return(tt.questionsRes.questions, nil, tt.questionsRes.err)
+			}
+
+			qc := &questionController{
+				Timeout:  time.Hour,
+				question: question,
+			}
+
+			app := fiber.New()
+			app.Get("/:tenderId/questions", qc.questions)
+
+			req := httptest.NewRequest("GET", "/"+TENDER_UUID.String()+"/questions?"+tt.req.query, nil)
+
+			resp, err := app.Test(req, int(qc.Timeout.Seconds()))
+			require.NoError(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tt.resp.body, string(respBody))
+			assert.Equal(t, tt.resp.code, resp.StatusCode)
+		})
+	}
+}
+
+func Test_questionController_answer(t *testing.T) {
+	type answerRes struct {
+		question models.QuestionOut
+		err      error
+	}
+	type resp struct {
+		body string
+		code int
+	}
+	answeredAt := time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name      string
+		body      string
+		answerRes *answerRes
+		resp      resp
+	}{
+		{
+			name: "main line",
+			body: `{"text": "yes", "public": true}`,
+			answerRes: &answerRes{models.QuestionOut{
+				Id:        QUESTION_UUID,
+				Username:  "supplier",
+				Text:      "is delivery included?",
+				CreatedAt: answeredAt,
+				Answer: &models.AnswerOut{
+					Username:  "employee",
+					Text:      "yes",
+					Public:    true,
+					CreatedAt: answeredAt,
+				},
+			}, nil},
+			resp: resp{`{
+				"id": "0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c",
+				"username": "supplier",
+				"text": "is delivery included?",
+				"createdAt": "2006-01-02T15:04:05Z",
+				"answer": {
+					"username": "employee",
+					"text": "yes",
+					"public": true,
+					"createdAt": "2006-01-02T15:04:05Z"
+				}
+			}`, 200},
+		},
+		{
+			name: "empty text",
+			body: `{"text": "", "public": true}`,
+			resp: resp{`{"reason":"text must not be empty"}`, 400},
+		},
+		{
+			name:      "question not found",
+			body:      `{"text": "yes", "public": true}`,
+			answerRes: &answerRes{models.QuestionOut{}, service.ErrQuestionNotFound},
+			resp:      resp{`{"reason":"question not found"}`, 404},
+		},
+		{
+			name:      "not responsible",
+			body:      `{"text": "yes", "public": true}`,
+			answerRes: &answerRes{models.QuestionOut{}, &service.PermissionError{Action: models.ActionView}},
+			resp:      resp{`{"reason":"view permission required"}`, 403},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			question := mocks.NewQuestion(t)
+
+			if tt.answerRes != nil {
+				question.
+					On("Answer", mock.Anything, TENDER_UUID, QUESTION_UUID, models.AnswerNew{Text: "yes", Public: true}).
+					Return(tt.answerRes.question, tt.answerRes.err)
+			}
+
+			qc := &questionController{
+				Timeout:  time.Hour,
+				question: question,
+			}
+
+			app := fiber.New()
+			app.Put("/:tenderId/questions/:questionId/answer", qc.answer)
+
+			req := httptest.NewRequest("PUT", "/"+TENDER_UUID.String()+"/questions/"+QUESTION_UUID.String()+"/answer", bytes.NewBuffer([]byte(tt.body)))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req, int(qc.Timeout.Seconds()))
+			require.NoError(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tt.resp.body, string(respBody))
+			assert.Equal(t, tt.resp.code, resp.StatusCode)
+		})
+	}
+}
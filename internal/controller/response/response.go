@@ -0,0 +1,20 @@
+package response
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"tender/internal/models"
+	"tender/internal/service"
+)
+
+// Forbidden responds that user is not allowed to perform action.
+// Missing permission is reported as reason if it is known.
+func Forbidden(c *fiber.Ctx, err error, reason string) error {
+	var permErr *service.PermissionError
+	if errors.As(err, &permErr) {
+		reason = permErr.Error()
+	}
+	return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp(reason))
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"tender/internal/controller/response"
+	ptr "tender/internal/lib/utils/pointers"
 	valid "tender/internal/lib/validate"
 	"tender/internal/models"
 	"tender/internal/service"
@@ -40,6 +42,11 @@ func New(
 	// Group 05/tenders/version
 	app.Patch("/:tenderId/edit", ctr.edit)
 	app.Put("/:tenderId/rollback/:version", ctr.rollback)
+	app.Get("/:tenderId/versions", ctr.versions)
+	app.Get("/:tenderId/diff", ctr.diff)
+
+	// Registered last so that static routes like /my take precedence.
+	app.Get("/:tenderId", ctr.get)
 
 	return app
 }
@@ -52,17 +59,20 @@ type tenderController struct {
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name Tender
 type Tender interface {
 	New(context.Context, models.TenderNew) (models.TenderOut, error)
-	All(ctx context.Context, limit, offset int32, services []models.ServiceType) ([]models.TenderOut, error)
-	My(ctx context.Context, limit, offset int32, username string) ([]models.TenderOut, error)
-	Status(ctx context.Context, username string, tenderId uuid.UUID) (models.TenderStatus, error)
-	SetStatus(ctx context.Context, username string, tenderId uuid.UUID, status models.TenderStatus) (models.TenderOut, error)
-	Edit(ctx context.Context, username string, tenderId uuid.UUID, patch models.TenderPatch) (models.TenderOut, error)
-	Rollback(ctx context.Context, username string, tenderId uuid.UUID, version int32) (models.TenderOut, error)
+	All(ctx context.Context, limit, offset int32, after *models.Cursor, filter models.TenderFilter) ([]models.TenderOut, *models.Cursor, error)
+	My(ctx context.Context, limit, offset int32, after *models.Cursor, statuses []models.TenderStatus) ([]models.TenderOut, *models.Cursor, error)
+	Get(ctx context.Context, tenderId uuid.UUID) (models.TenderOut, error)
+	Status(ctx context.Context, tenderId uuid.UUID) (models.TenderStatus, error)
+	SetStatus(ctx context.Context, tenderId uuid.UUID, status models.TenderStatus) (models.TenderOut, error)
+	Edit(ctx context.Context, tenderId uuid.UUID, version int32, patch models.TenderPatch) (models.TenderOut, error)
+	Rollback(ctx context.Context, tenderId uuid.UUID, version int32) (models.TenderOut, error)
+	Versions(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.TenderOut, error)
+	Diff(ctx context.Context, tenderId uuid.UUID, from, to int32) (models.Diff, error)
 }
 
 // new creates new tender.
 func (t *tenderController) new(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
 	defer cancel()
 
 	var tenderNew models.TenderNew
@@ -80,24 +90,31 @@ func (t *tenderController) new(c *fiber.Ctx) error {
 
 	res, err := t.tender.New(ctx, tenderNew)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(res)
 }
 
-// all returns all public tenders.
+// all returns tenders matching filter.
 func (t *tenderController) all(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
 	defer cancel()
 
-	var services []models.ServiceType
+	filter := models.TenderFilter{Query: c.Query("q")}
+
 	if s := c.Query("service_type"); s != "" {
 		splitted := strings.Split(s, ",")
-		services = make([]models.ServiceType, 0, len(splitted))
+		filter.ServiceTypes = make([]models.ServiceType, 0, len(splitted))
 		for _, el := range splitted {
 			t, err := models.StrToServiceType(el)
 			if err != nil {
@@ -106,44 +123,166 @@ func (t *tenderController) all(c *fiber.Ctx) error {
 					return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
 				}
 			}
-			services = append(services, t)
+			filter.ServiceTypes = append(filter.ServiceTypes, t)
+		}
+	}
+
+	if s := c.Query("status"); s != "" {
+		splitted := strings.Split(s, ",")
+		filter.Statuses = make([]models.TenderStatus, 0, len(splitted))
+		for _, el := range splitted {
+			st, err := models.StrToTenderStatus(el)
+			if err != nil {
+				var parseErr *models.Error
+				if errors.As(err, &parseErr) {
+					return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+				}
+			}
+			filter.Statuses = append(filter.Statuses, st)
+		}
+	}
+
+	if s := c.Query("organization_id"); s != "" {
+		orgId, err := uuid.Parse(s)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid organization id"))
+		}
+		filter.OrgId = &orgId
+	}
+
+	if s := c.Query("created_from"); s != "" {
+		from, err := parseTime(s, false)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid created_from"))
+		}
+		filter.CreatedFrom = &from
+	}
+	if s := c.Query("created_to"); s != "" {
+		to, err := parseTime(s, true)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid created_to"))
+		}
+		filter.CreatedTo = &to
+	}
+
+	if s := c.Query("version"); s != "" {
+		version, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || version < 1 {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid version"))
+		}
+		filter.Version = ptr.Ptr(int32(version))
+	}
+
+	if s := c.Query("sort"); s != "" {
+		sort, err := models.StrToTenderSort(s)
+		if err != nil {
+			var parseErr *models.Error
+			if errors.As(err, &parseErr) {
+				return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+			}
 		}
+		filter.Sort = sort
 	}
 
 	limit := int32(c.QueryInt("limit", 5))
 	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
 
-	res, err := t.tender.All(ctx, limit, offset, services)
+	after, err := models.ParseCursor(c.Query("cursor"))
 	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid cursor"))
+	}
+
+	res, next, err := t.tender.All(ctx, limit, offset, after, filter)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
-	return c.Status(fiber.StatusOK).JSON(res)
+	return c.Status(fiber.StatusOK).JSON(models.NewPage(res, next))
 }
 
-// user returns all user's tenders.
+// my returns tenders of organizations user is responsible for.
 func (t *tenderController) my(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
 	defer cancel()
 
+	var statuses []models.TenderStatus
+	if s := c.Query("status"); s != "" {
+		splitted := strings.Split(s, ",")
+		statuses = make([]models.TenderStatus, 0, len(splitted))
+		for _, el := range splitted {
+			st, err := models.StrToTenderStatus(el)
+			if err != nil {
+				var parseErr *models.Error
+				if errors.As(err, &parseErr) {
+					return c.Status(fiber.StatusBadRequest).JSON(parseErr.Response())
+				}
+			}
+			statuses = append(statuses, st)
+		}
+	}
+
 	limit := int32(c.QueryInt("limit", 5))
 	offset := int32(c.QueryInt("offset", 0))
-	username := c.Query("username")
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
 
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
+	after, err := models.ParseCursor(c.Query("cursor"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid cursor"))
 	}
 
-	res, err := t.tender.My(ctx, limit, offset, username)
+	res, next, err := t.tender.My(ctx, limit, offset, after, statuses)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
-		c.SendStatus(fiber.StatusInternalServerError)
+		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
-	if res == nil {
-		res = []models.TenderOut{}
+	return c.Status(fiber.StatusOK).JSON(models.NewPage(res, next))
+}
+
+// get returns tender by its id.
+func (t *tenderController) get(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	res, err := t.tender.Get(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(res)
@@ -151,21 +290,19 @@ func (t *tenderController) my(c *fiber.Ctx) error {
 
 // status returns tender's status.
 func (t *tenderController) status(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	tenderId, err := uuid.Parse(c.Params("tenderId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
 	}
 
-	res, err := t.tender.Status(ctx, username, tenderId)
+	res, err := t.tender.Status(ctx, tenderId)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -173,7 +310,7 @@ func (t *tenderController) status(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("tender not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action"))
+			return response.Forbidden(c, err, "unallowed action")
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -183,14 +320,9 @@ func (t *tenderController) status(c *fiber.Ctx) error {
 
 // status updates tender's status.
 func (t *tenderController) statusUpd(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	tenderId, err := uuid.Parse(c.Params("tenderId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
@@ -204,8 +336,11 @@ func (t *tenderController) statusUpd(c *fiber.Ctx) error {
 		}
 	}
 
-	res, err := t.tender.SetStatus(ctx, username, tenderId, status)
+	res, err := t.tender.SetStatus(ctx, tenderId, status)
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -213,7 +348,10 @@ func (t *tenderController) statusUpd(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action for user"))
+			return response.Forbidden(c, err, "unallowed action for user")
+		}
+		if errors.Is(err, service.ErrInvalidTransition) {
+			return c.Status(fiber.StatusConflict).JSON(models.ErrorResp("invalid status transition"))
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -223,19 +361,19 @@ func (t *tenderController) statusUpd(c *fiber.Ctx) error {
 
 // edit update tender.
 func (t *tenderController) edit(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	tenderId, err := uuid.Parse(c.Params("tenderId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
 	}
 
+	version, err := valid.Version(c.Get(fiber.HeaderIfMatch), c.Query("version"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp(err.Error()))
+	}
+
 	var patch models.TenderPatch
 
 	if err := c.BodyParser(&patch); err != nil {
@@ -246,8 +384,19 @@ func (t *tenderController) edit(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid json"))
 	}
 
-	res, err := t.tender.Edit(ctx, username, tenderId, patch)
+	res, err := t.tender.Edit(ctx, tenderId, version, patch)
 	if err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			// Precondition given by client failed, otherwise tender was modified concurrently.
+			status := fiber.StatusConflict
+			if version != 0 {
+				status = fiber.StatusPreconditionFailed
+			}
+			return c.Status(status).JSON(models.VersionConflictResp("version conflict", res.Version))
+		}
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
@@ -255,7 +404,7 @@ func (t *tenderController) edit(c *fiber.Ctx) error {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action"))
+			return response.Forbidden(c, err, "unallowed action")
 		}
 		return c.SendStatus(fiber.StatusInternalServerError)
 	}
@@ -265,14 +414,9 @@ func (t *tenderController) edit(c *fiber.Ctx) error {
 
 // rollback rollbacks tender to previous version.
 func (t *tenderController) rollback(c *fiber.Ctx) error {
-	ctx, cancel := context.WithTimeout(context.Background(), t.Timeout)
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
 	defer cancel()
 
-	username := c.Query("username")
-	if err := valid.Validate(username, "username", 100); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp(err.Error()))
-	}
-
 	tenderId, err := uuid.Parse(c.Params("tenderId"))
 	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
@@ -283,13 +427,16 @@ func (t *tenderController) rollback(c *fiber.Ctx) error {
 		c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid version"))
 	}
 
-	res, err := t.tender.Rollback(ctx, username, tenderId, int32(versionInt64))
+	res, err := t.tender.Rollback(ctx, tenderId, int32(versionInt64))
 	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
 		if errors.Is(err, service.ErrUserNotFound) {
 			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
 		}
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
-			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResp("unallowed action"))
+			return response.Forbidden(c, err, "unallowed action")
 		}
 		if errors.Is(err, service.ErrTenderNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
@@ -302,3 +449,106 @@ func (t *tenderController) rollback(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(res)
 }
+
+// versions returns outdated versions of tender.
+func (t *tenderController) versions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
+	defer cancel()
+
+	limit := int32(c.QueryInt("limit", 5))
+	offset := int32(c.QueryInt("offset", 0))
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid limit"))
+	}
+	if offset < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid offset"))
+	}
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	res, err := t.tender.Versions(ctx, tenderId, limit, offset)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	if res == nil {
+		res = []models.TenderOut{}
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// diff returns changes between two versions of tender.
+func (t *tenderController) diff(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), t.Timeout)
+	defer cancel()
+
+	tenderId, err := uuid.Parse(c.Params("tenderId"))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("invalid tender id"))
+	}
+
+	from, err := strconv.ParseInt(c.Query("from"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid from version"))
+	}
+	to, err := strconv.ParseInt(c.Query("to"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResp("invalid to version"))
+	}
+
+	res, err := t.tender.Diff(ctx, tenderId, int32(from), int32(to))
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("unauthorized"))
+		}
+		if errors.Is(err, service.ErrUserNotFound) {
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResp("user not found"))
+		}
+		if errors.Is(err, service.ErrTenderNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("tender not found"))
+		}
+		if errors.Is(err, service.ErrVersionNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResp("version not found"))
+		}
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return response.Forbidden(c, err, "unallowed action")
+		}
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// parseTime parses RFC 3339 time or date.
+// If end is set, date is treated as the end of the day.
+func parseTime(s string, end bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	return t, nil
+}
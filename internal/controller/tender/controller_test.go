@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"tender/internal/controller/tender/mocks"
+	"tender/internal/lib/auth"
 	ptr "tender/internal/lib/utils/pointers"
 	"tender/internal/models"
 	"tender/internal/service"
@@ -187,10 +188,12 @@ func Test_tenderController_edit(t *testing.T) {
 		body     string
 		username string
 		tenderId uuid.UUID
+		ifMatch  string
 	}
 	type editRes struct {
-		tender models.TenderOut
-		err    error
+		version int32
+		tender  models.TenderOut
+		err     error
 	}
 	type resp struct {
 		body string
@@ -210,8 +213,8 @@ func Test_tenderController_edit(t *testing.T) {
 				"name": "new name",
 				"description": "new awful description",
 				"serviceType": "Delivery"
-			}`, "user", ID_UUID},
-			editRes: &editRes{models.TenderOut{
+			}`, "user", ID_UUID, ""},
+			editRes: &editRes{0, models.TenderOut{
 				TenderBase: models.TenderBase{
 					OrgId:       ORG_UUID,
 					Name:        "new name",
@@ -234,6 +237,23 @@ func Test_tenderController_edit(t *testing.T) {
 				"createdAt": "2006-01-02T15:04:05+03:00"
 			}`, 200},
 		},
+		{
+			name:   "version conflict",
+			fields: fields{time.Hour},
+			req: req{`{
+				"name": "new name",
+				"description": "new awful description",
+				"serviceType": "Delivery"
+			}`, "user", ID_UUID, `"1"`},
+			editRes: &editRes{1, models.TenderOut{
+				Id:      ID_UUID,
+				Version: 2,
+			}, service.ErrVersionConflict},
+			resp: resp{`{
+				"reason": "version conflict",
+				"version": 2
+			}`, 412},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -241,7 +261,7 @@ func Test_tenderController_edit(t *testing.T) {
 
 			if tt.editRes != nil {
 				tender.
-					On("Edit", mock.Anything, tt.req.username, tt.req.tenderId, models.TenderPatch{
+					On("Edit", mock.Anything, tt.req.tenderId, tt.editRes.version, models.TenderPatch{
 						Name:        ptr.Ptr("new name"),
 						Desc:        ptr.Ptr("new awful description"),
 						ServiceType: ptr.Ptr(models.Delivery),
@@ -255,14 +275,110 @@ func Test_tenderController_edit(t *testing.T) {
 			}
 
 			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				c.SetUserContext(auth.WithUsername(c.UserContext(), tt.req.username))
+				return c.Next()
+			})
 			app.Patch("/:tenderId/edit", tr.edit)
 
 			req := httptest.NewRequest(
 				"PATCH",
-				fmt.Sprintf("/%s/edit?username=%s", tt.req.tenderId.String(), tt.req.username),
+				fmt.Sprintf("/%s/edit", tt.req.tenderId.String()),
 				bytes.NewBuffer([]byte(tt.req.body)),
 			)
 			req.Header.Set("Content-Type", "application/json")
+			if tt.req.ifMatch != "" {
+				req.Header.Set("If-Match", tt.req.ifMatch)
+			}
+
+			resp, err := app.Test(req, int(tr.Timeout.Seconds()))
+			require.NoError(t, err)
+
+			respBody, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.JSONEq(t, tt.resp.body, string(respBody))
+			assert.Equal(t, tt.resp.code, resp.StatusCode)
+		})
+	}
+}
+
+func Test_tenderController_all(t *testing.T) {
+	type req struct {
+		query string
+	}
+	type allRes struct {
+		limit, offset int32
+		after         *models.Cursor
+		tenders       []models.TenderOut
+		next          *models.Cursor
+	}
+	type resp struct {
+		body string
+		code int
+	}
+	cursor := models.Cursor{Name: "a", Id: ID_UUID}
+	next := models.Cursor{Name: "b", Id: ID_UUID2}
+	tests := []struct {
+		name   string
+		req    req
+		allRes *allRes
+		resp   resp
+	}{
+		{
+			name:   "first page",
+			req:    req{"limit=1"},
+			allRes: &allRes{1, 0, nil, []models.TenderOut{{Id: ID_UUID, Version: 1}}, &next},
+			resp: resp{fmt.Sprintf(`{
+				"items": [{"id": "98abb192-f64d-44d6-9fcb-a2b0844c62bd", "name": "", "description": "", "status": "", "organizationId": "00000000-0000-0000-0000-000000000000", "serviceType": "", "version": 1, "createdAt": "0001-01-01T00:00:00Z"}],
+				"nextCursor": %q
+			}`, next.String()), 200},
+		},
+		{
+			name:   "last page",
+			req:    req{"limit=5&offset=3&cursor=" + cursor.String()},
+			allRes: &allRes{5, 3, &cursor, nil, nil},
+			resp:   resp{`{"items": []}`, 200},
+		},
+		{
+			name: "zero limit",
+			req:  req{"limit=0"},
+			resp: resp{`{"reason": "invalid limit"}`, 400},
+		},
+		{
+			name: "negative limit",
+			req:  req{"limit=-1"},
+			resp: resp{`{"reason": "invalid limit"}`, 400},
+		},
+		{
+			name: "invalid cursor",
+			req:  req{"cursor=abc"},
+			resp: resp{`{"reason": "invalid cursor"}`, 400},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tender := mocks.NewTender(t)
+
+			if tt.allRes != nil {
+				tender.
+					On("All", mock.Anything, tt.allRes.limit, tt.allRes.offset, tt.allRes.after, models.TenderFilter{}).
+					Return(tt.allRes.tenders, tt.allRes.next, nil)
+			}
+
+			tr := &tenderController{
+				Timeout: time.Hour,
+				tender:  tender,
+			}
+
+			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				c.SetUserContext(auth.WithUsername(c.UserContext(), "user"))
+				return c.Next()
+			})
+			app.Get("/", tr.all)
+
+			req := httptest.NewRequest("GET", "/?"+tt.req.query, nil)
 
 			resp, err := app.Test(req, int(tr.Timeout.Seconds()))
 			require.NoError(t, err)
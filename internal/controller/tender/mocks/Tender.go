@@ -4,11 +4,10 @@ package mocks
 
 import (
 	context "context"
+	models "tender/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
 
-	models "tender/internal/models"
-
 	uuid "github.com/google/uuid"
 )
 
@@ -17,29 +16,66 @@ type Tender struct {
 	mock.Mock
 }
 
-// All provides a mock function with given fields: ctx, limit, offset, services
-func (_m *Tender) All(ctx context.Context, limit int32, offset int32, services []models.ServiceType) ([]models.TenderOut, error) {
-	ret := _m.Called(ctx, limit, offset, services)
+// All provides a mock function with given fields: ctx, limit, offset, after, filter
+func (_m *Tender) All(ctx context.Context, limit int32, offset int32, after *models.Cursor, filter models.TenderFilter) ([]models.TenderOut, *models.Cursor, error) {
+	ret := _m.Called(ctx, limit, offset, after, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for All")
 	}
 
 	var r0 []models.TenderOut
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, []models.ServiceType) ([]models.TenderOut, error)); ok {
-		return rf(ctx, limit, offset, services)
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) ([]models.TenderOut, *models.Cursor, error)); ok {
+		return rf(ctx, limit, offset, after, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, []models.ServiceType) []models.TenderOut); ok {
-		r0 = rf(ctx, limit, offset, services)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) []models.TenderOut); ok {
+		r0 = rf(ctx, limit, offset, after, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.TenderOut)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, []models.ServiceType) error); ok {
-		r1 = rf(ctx, limit, offset, services)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) *models.Cursor); ok {
+		r1 = rf(ctx, limit, offset, after, filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) error); ok {
+		r2 = rf(ctx, limit, offset, after, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Diff provides a mock function with given fields: ctx, tenderId, from, to
+func (_m *Tender) Diff(ctx context.Context, tenderId uuid.UUID, from int32, to int32) (models.Diff, error) {
+	ret := _m.Called(ctx, tenderId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Diff")
+	}
+
+	var r0 models.Diff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) (models.Diff, error)); ok {
+		return rf(ctx, tenderId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) models.Diff); ok {
+		r0 = rf(ctx, tenderId, from, to)
+	} else {
+		r0 = ret.Get(0).(models.Diff)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32) error); ok {
+		r1 = rf(ctx, tenderId, from, to)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -47,9 +83,9 @@ func (_m *Tender) All(ctx context.Context, limit int32, offset int32, services [
 	return r0, r1
 }
 
-// Edit provides a mock function with given fields: ctx, username, tenderId, patch
-func (_m *Tender) Edit(ctx context.Context, username string, tenderId uuid.UUID, patch models.TenderPatch) (models.TenderOut, error) {
-	ret := _m.Called(ctx, username, tenderId, patch)
+// Edit provides a mock function with given fields: ctx, tenderId, version, patch
+func (_m *Tender) Edit(ctx context.Context, tenderId uuid.UUID, version int32, patch models.TenderPatch) (models.TenderOut, error) {
+	ret := _m.Called(ctx, tenderId, version, patch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Edit")
@@ -57,17 +93,17 @@ func (_m *Tender) Edit(ctx context.Context, username string, tenderId uuid.UUID,
 
 	var r0 models.TenderOut
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, models.TenderPatch) (models.TenderOut, error)); ok {
-		return rf(ctx, username, tenderId, patch)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, models.TenderPatch) (models.TenderOut, error)); ok {
+		return rf(ctx, tenderId, version, patch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, models.TenderPatch) models.TenderOut); ok {
-		r0 = rf(ctx, username, tenderId, patch)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, models.TenderPatch) models.TenderOut); ok {
+		r0 = rf(ctx, tenderId, version, patch)
 	} else {
 		r0 = ret.Get(0).(models.TenderOut)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, models.TenderPatch) error); ok {
-		r1 = rf(ctx, username, tenderId, patch)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, models.TenderPatch) error); ok {
+		r1 = rf(ctx, tenderId, version, patch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,34 +111,71 @@ func (_m *Tender) Edit(ctx context.Context, username string, tenderId uuid.UUID,
 	return r0, r1
 }
 
-// My provides a mock function with given fields: ctx, limit, offset, username
-func (_m *Tender) My(ctx context.Context, limit int32, offset int32, username string) ([]models.TenderOut, error) {
-	ret := _m.Called(ctx, limit, offset, username)
+// Get provides a mock function with given fields: ctx, tenderId
+func (_m *Tender) Get(ctx context.Context, tenderId uuid.UUID) (models.TenderOut, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.TenderOut
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.TenderOut, error)); ok {
+		return rf(ctx, tenderId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.TenderOut); ok {
+		r0 = rf(ctx, tenderId)
+	} else {
+		r0 = ret.Get(0).(models.TenderOut)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// My provides a mock function with given fields: ctx, limit, offset, after, statuses
+func (_m *Tender) My(ctx context.Context, limit int32, offset int32, after *models.Cursor, statuses []models.TenderStatus) ([]models.TenderOut, *models.Cursor, error) {
+	ret := _m.Called(ctx, limit, offset, after, statuses)
 
 	if len(ret) == 0 {
 		panic("no return value specified for My")
 	}
 
 	var r0 []models.TenderOut
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, string) ([]models.TenderOut, error)); ok {
-		return rf(ctx, limit, offset, username)
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, []models.TenderStatus) ([]models.TenderOut, *models.Cursor, error)); ok {
+		return rf(ctx, limit, offset, after, statuses)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, string) []models.TenderOut); ok {
-		r0 = rf(ctx, limit, offset, username)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, []models.TenderStatus) []models.TenderOut); ok {
+		r0 = rf(ctx, limit, offset, after, statuses)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.TenderOut)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, string) error); ok {
-		r1 = rf(ctx, limit, offset, username)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, *models.Cursor, []models.TenderStatus) *models.Cursor); ok {
+		r1 = rf(ctx, limit, offset, after, statuses)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, int32, int32, *models.Cursor, []models.TenderStatus) error); ok {
+		r2 = rf(ctx, limit, offset, after, statuses)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // New provides a mock function with given fields: _a0, _a1
@@ -133,9 +206,9 @@ func (_m *Tender) New(_a0 context.Context, _a1 models.TenderNew) (models.TenderO
 	return r0, r1
 }
 
-// Rollback provides a mock function with given fields: ctx, username, tenderId, version
-func (_m *Tender) Rollback(ctx context.Context, username string, tenderId uuid.UUID, version int32) (models.TenderOut, error) {
-	ret := _m.Called(ctx, username, tenderId, version)
+// Rollback provides a mock function with given fields: ctx, tenderId, version
+func (_m *Tender) Rollback(ctx context.Context, tenderId uuid.UUID, version int32) (models.TenderOut, error) {
+	ret := _m.Called(ctx, tenderId, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Rollback")
@@ -143,17 +216,17 @@ func (_m *Tender) Rollback(ctx context.Context, username string, tenderId uuid.U
 
 	var r0 models.TenderOut
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, int32) (models.TenderOut, error)); ok {
-		return rf(ctx, username, tenderId, version)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) (models.TenderOut, error)); ok {
+		return rf(ctx, tenderId, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, int32) models.TenderOut); ok {
-		r0 = rf(ctx, username, tenderId, version)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) models.TenderOut); ok {
+		r0 = rf(ctx, tenderId, version)
 	} else {
 		r0 = ret.Get(0).(models.TenderOut)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, int32) error); ok {
-		r1 = rf(ctx, username, tenderId, version)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32) error); ok {
+		r1 = rf(ctx, tenderId, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -161,9 +234,9 @@ func (_m *Tender) Rollback(ctx context.Context, username string, tenderId uuid.U
 	return r0, r1
 }
 
-// SetStatus provides a mock function with given fields: ctx, username, tenderId, status
-func (_m *Tender) SetStatus(ctx context.Context, username string, tenderId uuid.UUID, status models.TenderStatus) (models.TenderOut, error) {
-	ret := _m.Called(ctx, username, tenderId, status)
+// SetStatus provides a mock function with given fields: ctx, tenderId, status
+func (_m *Tender) SetStatus(ctx context.Context, tenderId uuid.UUID, status models.TenderStatus) (models.TenderOut, error) {
+	ret := _m.Called(ctx, tenderId, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SetStatus")
@@ -171,17 +244,17 @@ func (_m *Tender) SetStatus(ctx context.Context, username string, tenderId uuid.
 
 	var r0 models.TenderOut
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, models.TenderStatus) (models.TenderOut, error)); ok {
-		return rf(ctx, username, tenderId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TenderStatus) (models.TenderOut, error)); ok {
+		return rf(ctx, tenderId, status)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, models.TenderStatus) models.TenderOut); ok {
-		r0 = rf(ctx, username, tenderId, status)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, models.TenderStatus) models.TenderOut); ok {
+		r0 = rf(ctx, tenderId, status)
 	} else {
 		r0 = ret.Get(0).(models.TenderOut)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, models.TenderStatus) error); ok {
-		r1 = rf(ctx, username, tenderId, status)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, models.TenderStatus) error); ok {
+		r1 = rf(ctx, tenderId, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -189,9 +262,9 @@ func (_m *Tender) SetStatus(ctx context.Context, username string, tenderId uuid.
 	return r0, r1
 }
 
-// Status provides a mock function with given fields: ctx, username, tenderId
-func (_m *Tender) Status(ctx context.Context, username string, tenderId uuid.UUID) (models.TenderStatus, error) {
-	ret := _m.Called(ctx, username, tenderId)
+// Status provides a mock function with given fields: ctx, tenderId
+func (_m *Tender) Status(ctx context.Context, tenderId uuid.UUID) (models.TenderStatus, error) {
+	ret := _m.Called(ctx, tenderId)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Status")
@@ -199,17 +272,47 @@ func (_m *Tender) Status(ctx context.Context, username string, tenderId uuid.UUI
 
 	var r0 models.TenderStatus
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (models.TenderStatus, error)); ok {
-		return rf(ctx, username, tenderId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.TenderStatus, error)); ok {
+		return rf(ctx, tenderId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) models.TenderStatus); ok {
-		r0 = rf(ctx, username, tenderId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.TenderStatus); ok {
+		r0 = rf(ctx, tenderId)
 	} else {
 		r0 = ret.Get(0).(models.TenderStatus)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
-		r1 = rf(ctx, username, tenderId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, tenderId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Versions provides a mock function with given fields: ctx, tenderId, limit, offset
+func (_m *Tender) Versions(ctx context.Context, tenderId uuid.UUID, limit int32, offset int32) ([]models.TenderOut, error) {
+	ret := _m.Called(ctx, tenderId, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Versions")
+	}
+
+	var r0 []models.TenderOut
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) ([]models.TenderOut, error)); ok {
+		return rf(ctx, tenderId, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) []models.TenderOut); ok {
+		r0 = rf(ctx, tenderId, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TenderOut)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32) error); ok {
+		r1 = rf(ctx, tenderId, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
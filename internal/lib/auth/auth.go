@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// Authenticator resolves caller's username by access token.
+type Authenticator interface {
+	Authenticate(token string) (string, error)
+}
+
+// Chain tries authenticators one by one
+// until one of them accepts token.
+type Chain []Authenticator
+
+func (ch Chain) Authenticate(token string) (string, error) {
+	err := ErrInvalidToken
+	for _, a := range ch {
+		var username string
+		if username, err = a.Authenticate(token); err == nil {
+			return username, nil
+		}
+	}
+	return "", err
+}
+
+type ctxKey string
+
+const usernameKey ctxKey = "auth.username"
+
+// WithUsername returns context carrying caller's username.
+func WithUsername(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, usernameKey, username)
+}
+
+// Username returns caller's username stored in context.
+func Username(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(usernameKey).(string)
+	return username, ok
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"time"
+)
+
+// JWT authenticates users by signed json web tokens.
+// Username is taken from "sub" claim.
+type JWT struct {
+	alg     string
+	hmacKey []byte
+	rsaKey  *rsa.PublicKey
+}
+
+// NewHMAC returns authenticator of tokens signed with HS256.
+func NewHMAC(secret []byte) *JWT {
+	return &JWT{alg: "HS256", hmacKey: secret}
+}
+
+// NewRSA returns authenticator of tokens signed with RS256.
+// Public key must be PEM encoded in PKIX or PKCS1 form.
+func NewRSA(publicKey []byte) (*JWT, error) {
+	block, _ := pem.Decode(publicKey)
+	if block == nil {
+		return nil, errors.New("invalid pem block")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return &JWT{alg: "RS256", rsaKey: key}, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not rsa public key")
+	}
+
+	return &JWT{alg: "RS256", rsaKey: rsaKey}, nil
+}
+
+type header struct {
+	Alg string `json:"alg"`
+}
+
+type claims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+	NotBefore int64  `json:"nbf"`
+}
+
+func (j *JWT) Authenticate(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", ErrInvalidToken
+	}
+
+	// Algorithm must match configured key,
+	// otherwise token could be forged with another one.
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil || h.Alg != j.alg {
+		return "", ErrInvalidToken
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if err := j.verify(parts[0]+"."+parts[1], sig); err != nil {
+		return "", ErrInvalidToken
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil || c.Subject == "" {
+		return "", ErrInvalidToken
+	}
+
+	now := time.Now().Unix()
+	if c.ExpiresAt != 0 && now >= c.ExpiresAt {
+		return "", ErrTokenExpired
+	}
+	if c.NotBefore != 0 && now < c.NotBefore {
+		return "", ErrInvalidToken
+	}
+
+	return c.Subject, nil
+}
+
+// verify checks signature of signed part of token.
+func (j *JWT) verify(signed string, sig []byte) error {
+	switch j.alg {
+	case "HS256":
+		mac := hmac.New(sha256.New, j.hmacKey)
+		mac.Write([]byte(signed))
+		if !hmac.Equal(mac.Sum(nil), sig) {
+			return ErrInvalidToken
+		}
+		return nil
+	case "RS256":
+		hash := sha256.Sum256([]byte(signed))
+		return rsa.VerifyPKCS1v15(j.rsaKey, crypto.SHA256, hash[:], sig)
+	}
+	return ErrInvalidToken
+}
+
+func decodeSegment(seg string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func segment(s string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+func hs256(secret, header, payload string) string {
+	signed := segment(header) + "." + segment(payload)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestHMAC(t *testing.T) {
+	future := time.Now().Add(time.Hour).Unix()
+	past := time.Now().Add(-time.Hour).Unix()
+
+	tests := []struct {
+		name     string
+		token    string
+		username string
+		err      error
+	}{
+		{
+			name:     "main line",
+			token:    hs256("secret", `{"alg":"HS256"}`, `{"sub":"user","exp":`+strconv.FormatInt(future, 10)+`}`),
+			username: "user",
+		},
+		{
+			name:  "wrong secret",
+			token: hs256("other", `{"alg":"HS256"}`, `{"sub":"user"}`),
+			err:   ErrInvalidToken,
+		},
+		{
+			name:  "expired",
+			token: hs256("secret", `{"alg":"HS256"}`, `{"sub":"user","exp":`+strconv.FormatInt(past, 10)+`}`),
+			err:   ErrTokenExpired,
+		},
+		{
+			name:  "unsigned",
+			token: segment(`{"alg":"none"}`) + "." + segment(`{"sub":"user"}`) + ".",
+			err:   ErrInvalidToken,
+		},
+		{
+			name:  "no subject",
+			token: hs256("secret", `{"alg":"HS256"}`, `{}`),
+			err:   ErrInvalidToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			username, err := NewHMAC([]byte("secret")).Authenticate(tt.token)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.username, username)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestRSA(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	j, err := NewRSA(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, err)
+
+	signed := segment(`{"alg":"RS256"}`) + "." + segment(`{"sub":"user"}`)
+	hash := sha256.Sum256([]byte(signed))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+
+	username, err := j.Authenticate(signed + "." + base64.RawURLEncoding.EncodeToString(sig))
+	assert.NoError(t, err)
+	assert.Equal(t, "user", username)
+
+	// Token signed with public key as HMAC secret must be rejected.
+	_, err = j.Authenticate(hs256(string(der), `{"alg":"HS256"}`, `{"sub":"user"}`))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
@@ -0,0 +1,16 @@
+package auth
+
+import "crypto/subtle"
+
+// Tokens authenticates service accounts by static api tokens.
+// Key is token, value is username of account.
+type Tokens map[string]string
+
+func (t Tokens) Authenticate(token string) (string, error) {
+	for known, username := range t {
+		if subtle.ConstantTimeCompare([]byte(known), []byte(token)) == 1 {
+			return username, nil
+		}
+	}
+	return "", ErrInvalidToken
+}
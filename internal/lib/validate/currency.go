@@ -0,0 +1,17 @@
+package valid
+
+import "errors"
+
+// Currency validates ISO 4217 alphabetic currency code.
+func Currency(code string) error {
+	if len(code) != 3 {
+		return errors.New("currency must be 3-letter ISO 4217 code")
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return errors.New("currency must be 3-letter ISO 4217 code")
+		}
+	}
+
+	return nil
+}
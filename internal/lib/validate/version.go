@@ -0,0 +1,30 @@
+package valid
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Version parses expected version from If-Match header value
+// (entity tag like "3" or W/"3") or from query parameter if header is empty.
+// Zero means that any version is expected.
+func Version(ifMatch, query string) (int32, error) {
+	raw := strings.TrimSpace(ifMatch)
+	if raw == "" {
+		raw = query
+	}
+	if raw == "" || raw == "*" {
+		return 0, nil
+	}
+
+	raw = strings.TrimPrefix(raw, "W/")
+	raw = strings.Trim(raw, `"`)
+
+	version, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || version < 1 {
+		return 0, errors.New("invalid version")
+	}
+
+	return int32(version), nil
+}
@@ -15,6 +15,42 @@ type BidBase struct {
 	Desc       string     `json:"description"`
 	AuthorType AuthorType `json:"authorType"`
 	AuthorId   uuid.UUID  `json:"authorId"`
+	BidTerms
+}
+
+// BidTerms are commercial terms offered by bid.
+type BidTerms struct {
+	// Price in minor units of currency, e.g. cents.
+	Price          *int64  `json:"price,omitempty"`
+	Currency       *string `json:"currency,omitempty"`
+	DeliveryDays   *int32  `json:"deliveryDays,omitempty"`
+	WarrantyMonths *int32  `json:"warrantyMonths,omitempty"`
+}
+
+func (t *BidTerms) validate() error {
+	if (t.Price == nil) != (t.Currency == nil) {
+		return NewParseError("price and currency must be given together")
+	}
+
+	if t.Price != nil && *t.Price < 0 {
+		return NewParseError("price must not be negative")
+	}
+
+	if t.Currency != nil {
+		if err := valid.Currency(*t.Currency); err != nil {
+			return NewParseError(err.Error())
+		}
+	}
+
+	if t.DeliveryDays != nil && *t.DeliveryDays < 0 {
+		return NewParseError("delivery days must not be negative")
+	}
+
+	if t.WarrantyMonths != nil && *t.WarrantyMonths < 0 {
+		return NewParseError("warranty months must not be negative")
+	}
+
+	return nil
 }
 
 type BidNew struct {
@@ -30,6 +66,10 @@ func (b *BidNew) validate() error {
 		return NewParseError("description must not be longer than 500 characters")
 	}
 
+	if err := b.BidTerms.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -61,6 +101,7 @@ func (b *BidNew) ToBid() Bid {
 type BidPatch struct {
 	Name *string `json:"name"`
 	Desc *string `json:"description"`
+	BidTerms
 }
 
 func (b *BidPatch) validate() error {
@@ -72,6 +113,10 @@ func (b *BidPatch) validate() error {
 		return NewParseError("description must not be longer than 100 characters")
 	}
 
+	if err := b.BidTerms.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -85,6 +130,7 @@ func (b *BidPatch) UnmarshalJSON(data []byte) error {
 
 	b.Name = tmp.Name
 	b.Desc = tmp.Desc
+	b.BidTerms = tmp.BidTerms
 
 	if err := b.validate(); err != nil {
 		return err
@@ -127,4 +173,26 @@ func (b *Bid) Patch(patch BidPatch) {
 	if patch.Desc != nil {
 		b.Desc = *patch.Desc
 	}
+	if patch.Price != nil {
+		b.Price = patch.Price
+		b.Currency = patch.Currency
+	}
+	if patch.DeliveryDays != nil {
+		b.DeliveryDays = patch.DeliveryDays
+	}
+	if patch.WarrantyMonths != nil {
+		b.WarrantyMonths = patch.WarrantyMonths
+	}
+}
+
+// Public checks if bid is visible to everyone.
+// Draft, canceled and withdrawn bids are visible only
+// to their authors and tender's responsibles.
+func (b *Bid) Public() bool {
+	switch b.Status {
+	case BidPublished, BidApproved, BidRejected:
+		return true
+	default:
+		return false
+	}
 }
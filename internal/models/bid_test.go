@@ -0,0 +1,125 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	ptr "tender/internal/lib/utils/pointers"
+)
+
+func TestBidNewTerms(t *testing.T) {
+	s := `{
+		"name": "some name",
+		"description": "awful description",
+		"tenderId": "98abb192-f64d-44d6-9fcb-a2b0844c62bd",
+		"authorType": "User",
+		"authorId": "002f9d2b-cd76-4921-8e53-21dbde75f993",
+		"price": 150000,
+		"currency": "RUB",
+		"deliveryDays": 14,
+		"warrantyMonths": 12
+	}`
+	expect := BidNew{
+		BidBase: BidBase{
+			TenderId:   uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd"),
+			Name:       "some name",
+			Desc:       "awful description",
+			AuthorType: User,
+			AuthorId:   uuid.MustParse("002f9d2b-cd76-4921-8e53-21dbde75f993"),
+			BidTerms: BidTerms{
+				Price:          ptr.Ptr(int64(150000)),
+				Currency:       ptr.Ptr("RUB"),
+				DeliveryDays:   ptr.Ptr(int32(14)),
+				WarrantyMonths: ptr.Ptr(int32(12)),
+			},
+		},
+	}
+
+	var bid BidNew
+
+	err := json.Unmarshal([]byte(s), &bid)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, bid)
+}
+
+func TestBidTermsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"price without currency", `{"name": "n", "price": 100}`, "price and currency must be given together"},
+		{"negative price", `{"name": "n", "price": -1, "currency": "USD"}`, "price must not be negative"},
+		{"unknown currency format", `{"name": "n", "price": 1, "currency": "usd"}`, "currency must be 3-letter ISO 4217 code"},
+		{"negative delivery", `{"name": "n", "deliveryDays": -3}`, "delivery days must not be negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var bid BidNew
+
+			err := json.Unmarshal([]byte(tt.json), &bid)
+
+			var parseErr *Error
+			assert.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.want, parseErr.Response().Err)
+		})
+	}
+}
+
+func TestBidDiff(t *testing.T) {
+	from := Bid{
+		BidBase: BidBase{Name: "old name", Desc: "description"},
+		Status:  BidCreated,
+		Version: 1,
+	}
+	to := Bid{
+		BidBase: BidBase{Name: "new name", Desc: "description"},
+		Status:  BidPublished,
+		Version: 3,
+	}
+	expect := Diff{
+		From: 1,
+		To:   3,
+		Changes: []FieldDiff{
+			{Field: "name", From: "old name", To: "new name"},
+			{Field: "status", From: BidCreated, To: BidPublished},
+		},
+	}
+
+	assert.Equal(t, expect, BidDiff(from, to))
+	assert.Empty(t, BidDiff(to, to).Changes)
+}
+
+func TestBidDiffTerms(t *testing.T) {
+	from := Bid{
+		BidBase: BidBase{BidTerms: BidTerms{
+			Price:          ptr.Ptr(int64(100000)),
+			Currency:       ptr.Ptr("RUB"),
+			WarrantyMonths: ptr.Ptr(int32(12)),
+		}},
+		Version: 1,
+	}
+	// Equal values behind different pointers are not changes.
+	to := Bid{
+		BidBase: BidBase{BidTerms: BidTerms{
+			Price:          ptr.Ptr(int64(90000)),
+			Currency:       ptr.Ptr("RUB"),
+			DeliveryDays:   ptr.Ptr(int32(10)),
+			WarrantyMonths: ptr.Ptr(int32(12)),
+		}},
+		Version: 2,
+	}
+	expect := Diff{
+		From: 1,
+		To:   2,
+		Changes: []FieldDiff{
+			{Field: "price", From: int64(100000), To: int64(90000)},
+			{Field: "deliveryDays", From: nil, To: int32(10)},
+		},
+	}
+
+	assert.Equal(t, expect, BidDiff(from, to))
+}
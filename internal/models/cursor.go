@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cursor points to the last row of a page for keyset pagination.
+// Keys not used by the list ordering are ignored,
+// id always breaks ties.
+type Cursor struct {
+	Rank      float32   `json:"r,omitempty"`
+	Name      string    `json:"n,omitempty"`
+	CreatedAt time.Time `json:"c"`
+	Id        uuid.UUID `json:"i"`
+}
+
+// String returns opaque representation of cursor.
+func (c Cursor) String() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseCursor parses cursor returned by Cursor.String.
+// Empty string means first page, nil is returned.
+func ParseCursor(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, NewParseError("invalid cursor")
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Id == uuid.Nil {
+		return nil, NewParseError("invalid cursor")
+	}
+
+	return &c, nil
+}
+
+// Page is a part of list with cursor of the next part.
+// NextCursor is empty on the last page.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// NewPage returns page of items, nil items are returned as empty list.
+func NewPage[T any](items []T, next *Cursor) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+
+	page := Page[T]{Items: items}
+	if next != nil {
+		page.NextCursor = next.String()
+	}
+
+	return page
+}
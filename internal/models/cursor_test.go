@@ -0,0 +1,31 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	c := Cursor{
+		Rank:      0.5,
+		Name:      "name",
+		CreatedAt: time.Unix(10, 500).UTC(),
+		Id:        uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd"),
+	}
+
+	parsed, err := ParseCursor(c.String())
+	assert.NoError(t, err)
+	assert.Equal(t, &c, parsed)
+
+	parsed, err = ParseCursor("")
+	assert.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	for _, s := range []string{"%%%", "bm90IGpzb24", "e30"} {
+		_, err = ParseCursor(s)
+		assert.Error(t, err, s)
+	}
+}
@@ -1,9 +1,74 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"time"
 
+	"github.com/google/uuid"
+)
+
+// Decision is a vote of tender's responsible on bid.
+// Decisions are never overwritten, user's latest decision counts.
+// CarriedFromVersion is set for decision carried over to new version
+// of bid and holds version decision was originally made on.
 type Decision struct {
-	UserId   uuid.UUID
-	BidId    uuid.UUID
-	Decision DecisionType
+	Id                 uuid.UUID
+	UserId             uuid.UUID
+	Username           string
+	BidId              uuid.UUID
+	BidVersion         int32
+	CarriedFromVersion *int32
+	Decision           DecisionType
+	Comment            string
+	CreatedAt          time.Time
+}
+
+type DecisionOut struct {
+	Id                 uuid.UUID    `json:"id"`
+	Username           string       `json:"username"`
+	BidVersion         int32        `json:"bidVersion"`
+	CarriedFromVersion *int32       `json:"carriedFromVersion,omitempty"`
+	Decision           DecisionType `json:"decision"`
+	Comment            string       `json:"comment,omitempty"`
+	CreatedAt          time.Time    `json:"createdAt"`
+}
+
+func (d *Decision) ToOut() DecisionOut {
+	return DecisionOut{
+		Id:                 d.Id,
+		Username:           d.Username,
+		BidVersion:         d.BidVersion,
+		CarriedFromVersion: d.CarriedFromVersion,
+		Decision:           d.Decision,
+		Comment:            d.Comment,
+		CreatedAt:          d.CreatedAt,
+	}
+}
+
+// Tally is a current count of decisions on bid.
+type Tally struct {
+	Approvals  int64 `json:"approvals"`
+	Rejections int64 `json:"rejections"`
+	Required   int64 `json:"requiredQuorum"`
+}
+
+// NewTally counts decisions, required quorum is
+// determined by policy and # of responsibles.
+func NewTally(decisions []Decision, quorum Quorum, size int64) Tally {
+	tally := Tally{Required: quorum.Required(size)}
+	for _, d := range decisions {
+		switch d.Decision {
+		case Approved:
+			tally.Approvals++
+		case Rejected:
+			tally.Rejections++
+		}
+	}
+
+	return tally
+}
+
+// BidDecisionOut is bid after decision with the current tally.
+type BidDecisionOut struct {
+	BidOut
+	Tally Tally `json:"tally"`
 }
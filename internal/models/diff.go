@@ -0,0 +1,68 @@
+package models
+
+import "reflect"
+
+// FieldDiff describes change of single field.
+type FieldDiff struct {
+	Field string `json:"field"`
+	From  any    `json:"from"`
+	To    any    `json:"to"`
+}
+
+// Diff describes changes between two versions of tender or bid.
+type Diff struct {
+	From    int32       `json:"from"`
+	To      int32       `json:"to"`
+	Changes []FieldDiff `json:"changes"`
+}
+
+// add appends field to changes if its value differs.
+func (d *Diff) add(field string, from, to any) {
+	if !reflect.DeepEqual(from, to) {
+		d.Changes = append(d.Changes, FieldDiff{Field: field, From: from, To: to})
+	}
+}
+
+// deref returns value of optional field or nil if it is not set,
+// so equal values behind different pointers are not reported.
+func deref[T any](v *T) any {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// TenderDiff returns fields changed between two versions of tender.
+func TenderDiff(from, to Tender) Diff {
+	diff := Diff{From: from.Version, To: to.Version, Changes: []FieldDiff{}}
+
+	diff.add("name", from.Name, to.Name)
+	diff.add("description", from.Desc, to.Desc)
+	diff.add("serviceType", from.ServiceType, to.ServiceType)
+	diff.add("status", from.Status, to.Status)
+	diff.add("budget", deref(from.Budget), deref(to.Budget))
+	diff.add("currency", deref(from.Currency), deref(to.Currency))
+	diff.add("quantity", deref(from.Quantity), deref(to.Quantity))
+	diff.add("unit", deref(from.Unit), deref(to.Unit))
+	diff.add("location", deref(from.Location), deref(to.Location))
+	if len(from.Requirements) != 0 || len(to.Requirements) != 0 {
+		diff.add("requirements", from.Requirements, to.Requirements)
+	}
+
+	return diff
+}
+
+// BidDiff returns fields changed between two versions of bid.
+func BidDiff(from, to Bid) Diff {
+	diff := Diff{From: from.Version, To: to.Version, Changes: []FieldDiff{}}
+
+	diff.add("name", from.Name, to.Name)
+	diff.add("description", from.Desc, to.Desc)
+	diff.add("status", from.Status, to.Status)
+	diff.add("price", deref(from.Price), deref(to.Price))
+	diff.add("currency", deref(from.Currency), deref(to.Currency))
+	diff.add("deliveryDays", deref(from.DeliveryDays), deref(to.DeliveryDays))
+	diff.add("warrantyMonths", deref(from.WarrantyMonths), deref(to.WarrantyMonths))
+
+	return diff
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	valid "tender/internal/lib/validate"
+)
+
+type EmployeeBase struct {
+	Username  string `json:"username"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+type EmployeeNew struct {
+	EmployeeBase
+}
+
+func (e *EmployeeNew) validate() error {
+	if err := valid.Validate(e.Username, "username", 50); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	if len(e.FirstName) > 50 {
+		return NewParseError("first name must not be longer than 50 characters")
+	}
+
+	if len(e.LastName) > 50 {
+		return NewParseError("last name must not be longer than 50 characters")
+	}
+
+	return nil
+}
+
+func (e *EmployeeNew) UnmarshalJSON(data []byte) error {
+	type _employeeNew EmployeeNew
+
+	var tmp _employeeNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	e.EmployeeBase = tmp.EmployeeBase
+
+	if err := e.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *EmployeeNew) ToEmployee() Employee {
+	return Employee{
+		EmployeeBase: e.EmployeeBase,
+	}
+}
+
+type EmployeePatch struct {
+	FirstName *string `json:"firstName"`
+	LastName  *string `json:"lastName"`
+}
+
+func (e *EmployeePatch) validate() error {
+	if e.FirstName != nil && len(*e.FirstName) > 50 {
+		return NewParseError("first name must not be longer than 50 characters")
+	}
+
+	if e.LastName != nil && len(*e.LastName) > 50 {
+		return NewParseError("last name must not be longer than 50 characters")
+	}
+
+	return nil
+}
+
+func (e *EmployeePatch) UnmarshalJSON(data []byte) error {
+	type _employeePatch EmployeePatch
+
+	var tmp _employeePatch
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	e.FirstName = tmp.FirstName
+	e.LastName = tmp.LastName
+
+	if err := e.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type EmployeeOut struct {
+	EmployeeBase
+	Id        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Employee struct {
+	EmployeeBase
+	Id        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (e *Employee) ToOut() EmployeeOut {
+	return EmployeeOut{
+		EmployeeBase: e.EmployeeBase,
+		Id:           e.Id,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+	}
+}
+
+// Patch applies patch to employee.
+func (e *Employee) Patch(patch EmployeePatch) {
+	if patch.FirstName != nil {
+		e.FirstName = *patch.FirstName
+	}
+	if patch.LastName != nil {
+		e.LastName = *patch.LastName
+	}
+}
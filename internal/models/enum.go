@@ -1,10 +1,17 @@
 package models
 
+import "encoding/json"
+
 type TenderStatus string
 type BidStatus string
 type ServiceType string
 type AuthorType string
 type DecisionType string
+type OrgType string
+type TenderSort string
+type RequirementType string
+type CriterionType string
+type QuorumType string
 
 const (
 	TenderCreated   TenderStatus = "Created"
@@ -16,6 +23,9 @@ const (
 	BidCreated   BidStatus = "Created"
 	BidPublished BidStatus = "Published"
 	BidCanceled  BidStatus = "Canceled"
+	BidApproved  BidStatus = "Approved"
+	BidRejected  BidStatus = "Rejected"
+	BidWithdrawn BidStatus = "Withdrawn"
 )
 
 const (
@@ -34,6 +44,38 @@ const (
 	Rejected DecisionType = "Rejected"
 )
 
+const (
+	IE  OrgType = "IE"
+	LLC OrgType = "LLC"
+	JSC OrgType = "JSC"
+)
+
+const (
+	RequirementLicense     RequirementType = "License"
+	RequirementCertificate RequirementType = "Certificate"
+	RequirementExperience  RequirementType = "Experience"
+	RequirementOther       RequirementType = "Other"
+)
+
+const (
+	CriterionPrice    CriterionType = "Price"
+	CriterionQuality  CriterionType = "Quality"
+	CriterionDelivery CriterionType = "Delivery"
+	CriterionOther    CriterionType = "Other"
+)
+
+const (
+	QuorumCount     QuorumType = "count"
+	QuorumPercent   QuorumType = "percent"
+	QuorumUnanimous QuorumType = "unanimous"
+)
+
+const (
+	SortByName          TenderSort = "name"
+	SortByCreatedAt     TenderSort = "createdAt"
+	SortByCreatedAtDesc TenderSort = "-createdAt"
+)
+
 func StrToTenderStatus(s string) (TenderStatus, error) {
 	st := TenderStatus(s)
 	switch st {
@@ -62,7 +104,7 @@ func (s *TenderStatus) UnmarshalJSON(data []byte) error {
 func StrToBidStatus(s string) (BidStatus, error) {
 	st := BidStatus(s)
 	switch st {
-	case BidCreated, BidPublished, BidCanceled:
+	case BidCreated, BidPublished, BidCanceled, BidApproved, BidRejected, BidWithdrawn:
 		return st, nil
 	default:
 		return st, NewParseError("unknown bid status")
@@ -84,6 +126,17 @@ func (s *BidStatus) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Terminal checks if bid is decided, canceled or withdrawn.
+// Content of such bid can't be changed.
+func (s BidStatus) Terminal() bool {
+	switch s {
+	case BidCanceled, BidApproved, BidRejected, BidWithdrawn:
+		return true
+	default:
+		return false
+	}
+}
+
 func StrToServiceType(s string) (ServiceType, error) {
 	t := ServiceType(s)
 	switch t {
@@ -143,3 +196,138 @@ func StrToDecision(s string) (DecisionType, error) {
 		return d, NewParseError("unknown author type")
 	}
 }
+
+func StrToOrgType(s string) (OrgType, error) {
+	t := OrgType(s)
+	switch t {
+	case IE, LLC, JSC:
+		return t, nil
+	default:
+		return t, NewParseError("unknown organization type")
+	}
+}
+
+func (t *OrgType) UnmarshalJSON(data []byte) error {
+	n := len(data)
+	if n == 0 {
+		return NewParseError("unknown organization type")
+	}
+
+	tmp, err := StrToOrgType(string(data[1 : n-1]))
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
+
+func StrToRole(s string) (Role, error) {
+	r := Role(s)
+	switch r {
+	case RoleViewer, RoleEditor, RoleApprover, RoleOrgAdmin:
+		return r, nil
+	default:
+		return r, NewParseError("unknown role")
+	}
+}
+
+func (r *Role) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return NewParseError("role must be a string")
+	}
+
+	tmp, err := StrToRole(str)
+	if err != nil {
+		return err
+	}
+
+	*r = tmp
+	return nil
+}
+
+func StrToTenderSort(s string) (TenderSort, error) {
+	t := TenderSort(s)
+	switch t {
+	case SortByName, SortByCreatedAt, SortByCreatedAtDesc:
+		return t, nil
+	default:
+		return t, NewParseError("unknown sort")
+	}
+}
+
+func StrToRequirementType(s string) (RequirementType, error) {
+	t := RequirementType(s)
+	switch t {
+	case RequirementLicense, RequirementCertificate, RequirementExperience, RequirementOther:
+		return t, nil
+	default:
+		return t, NewParseError("unknown requirement type")
+	}
+}
+
+func (t *RequirementType) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return NewParseError("requirement type must be a string")
+	}
+
+	tmp, err := StrToRequirementType(str)
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
+
+func StrToCriterionType(s string) (CriterionType, error) {
+	t := CriterionType(s)
+	switch t {
+	case CriterionPrice, CriterionQuality, CriterionDelivery, CriterionOther:
+		return t, nil
+	default:
+		return t, NewParseError("unknown criterion type")
+	}
+}
+
+func (t *CriterionType) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return NewParseError("criterion type must be a string")
+	}
+
+	tmp, err := StrToCriterionType(str)
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
+
+func StrToQuorumType(s string) (QuorumType, error) {
+	t := QuorumType(s)
+	switch t {
+	case QuorumCount, QuorumPercent, QuorumUnanimous:
+		return t, nil
+	default:
+		return t, NewParseError("unknown quorum type")
+	}
+}
+
+func (t *QuorumType) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return NewParseError("quorum type must be a string")
+	}
+
+	tmp, err := StrToQuorumType(str)
+	if err != nil {
+		return err
+	}
+
+	*t = tmp
+	return nil
+}
@@ -8,6 +8,17 @@ func ErrorResp(err string) *ErrorResponse {
 	return &ErrorResponse{Err: err}
 }
 
+// VersionConflictResponse is returned when expected version
+// of tender or bid doesn't match the current one.
+type VersionConflictResponse struct {
+	Err     string `json:"reason"`
+	Version int32  `json:"version"`
+}
+
+func VersionConflictResp(err string, version int32) *VersionConflictResponse {
+	return &VersionConflictResponse{Err: err, Version: version}
+}
+
 type Error struct {
 	UserCaused bool
 	desc       string
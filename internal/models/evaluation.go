@@ -0,0 +1,241 @@
+package models
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/google/uuid"
+
+	valid "tender/internal/lib/validate"
+)
+
+type CriterionBase struct {
+	Name   string        `json:"name"`
+	Type   CriterionType `json:"type"`
+	Weight int32         `json:"weight"`
+}
+
+func (c *CriterionBase) validate() error {
+	if err := valid.Validate(c.Name, "criterion name", 100); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	if c.Type == "" {
+		return NewParseError("criterion type must not be empty")
+	}
+
+	if c.Weight < 1 || c.Weight > 100 {
+		return NewParseError("criterion weight must be between 1 and 100")
+	}
+
+	return nil
+}
+
+// CriteriaNew is a full list of tender's evaluation criteria.
+type CriteriaNew struct {
+	Criteria []CriterionBase `json:"criteria"`
+}
+
+func (c *CriteriaNew) validate() error {
+	if len(c.Criteria) > 20 {
+		return NewParseError("there must be no more than 20 criteria")
+	}
+
+	names := make(map[string]struct{}, len(c.Criteria))
+	for i := range c.Criteria {
+		if err := c.Criteria[i].validate(); err != nil {
+			return err
+		}
+		if _, ok := names[c.Criteria[i].Name]; ok {
+			return NewParseError("criterion names must be unique")
+		}
+		names[c.Criteria[i].Name] = struct{}{}
+	}
+
+	return nil
+}
+
+func (c *CriteriaNew) UnmarshalJSON(data []byte) error {
+	type _criteriaNew CriteriaNew
+
+	var tmp _criteriaNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	c.Criteria = tmp.Criteria
+
+	if err := c.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ToCriteria returns criteria of tender.
+func (c *CriteriaNew) ToCriteria(tenderId uuid.UUID) []Criterion {
+	criteria := make([]Criterion, 0, len(c.Criteria))
+	for _, base := range c.Criteria {
+		criteria = append(criteria, Criterion{
+			CriterionBase: base,
+			TenderId:      tenderId,
+		})
+	}
+
+	return criteria
+}
+
+type CriterionOut struct {
+	CriterionBase
+	Id uuid.UUID `json:"id"`
+}
+
+type Criterion struct {
+	CriterionBase
+	Id       uuid.UUID
+	TenderId uuid.UUID
+}
+
+func (c *Criterion) ToOut() CriterionOut {
+	return CriterionOut{
+		CriterionBase: c.CriterionBase,
+		Id:            c.Id,
+	}
+}
+
+type ScoreBase struct {
+	CriterionId uuid.UUID `json:"criterionId"`
+	Score       int32     `json:"score"`
+}
+
+// ScoresNew is a list of scores given to bid by one employee.
+type ScoresNew struct {
+	Scores []ScoreBase `json:"scores"`
+}
+
+func (s *ScoresNew) validate() error {
+	if len(s.Scores) == 0 {
+		return NewParseError("scores must not be empty")
+	}
+
+	criteria := make(map[uuid.UUID]struct{}, len(s.Scores))
+	for _, score := range s.Scores {
+		if score.Score < 0 || score.Score > 10 {
+			return NewParseError("score must be between 0 and 10")
+		}
+		if _, ok := criteria[score.CriterionId]; ok {
+			return NewParseError("criterion must be scored once")
+		}
+		criteria[score.CriterionId] = struct{}{}
+	}
+
+	return nil
+}
+
+func (s *ScoresNew) UnmarshalJSON(data []byte) error {
+	type _scoresNew ScoresNew
+
+	var tmp _scoresNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	s.Scores = tmp.Scores
+
+	if err := s.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ToScores returns scores given by user to bid.
+func (s *ScoresNew) ToScores(userId, bidId uuid.UUID) []Score {
+	scores := make([]Score, 0, len(s.Scores))
+	for _, base := range s.Scores {
+		scores = append(scores, Score{
+			ScoreBase: base,
+			UserId:    userId,
+			BidId:     bidId,
+		})
+	}
+
+	return scores
+}
+
+type Score struct {
+	ScoreBase
+	UserId uuid.UUID
+	BidId  uuid.UUID
+}
+
+// CriterionScore is an aggregated score of bid by criterion.
+type CriterionScore struct {
+	BidId       uuid.UUID `json:"-"`
+	CriterionId uuid.UUID `json:"criterionId"`
+	Average     float64   `json:"average"`
+	Count       int32     `json:"count"`
+}
+
+type BidRankOut struct {
+	Place  int32            `json:"place"`
+	Score  float64          `json:"score"`
+	Bid    BidOut           `json:"bid"`
+	Scores []CriterionScore `json:"scores"`
+}
+
+// Rank orders bids by weighted average of their criterion scores,
+// best first. Criterion without scores counts as zero.
+// Bids with equal score keep their order.
+func Rank(bids []Bid, criteria []Criterion, scores []CriterionScore) []BidRankOut {
+	var total int32
+	weights := make(map[uuid.UUID]int32, len(criteria))
+	for _, c := range criteria {
+		weights[c.Id] = c.Weight
+		total += c.Weight
+	}
+
+	byBid := make(map[uuid.UUID][]CriterionScore, len(bids))
+	for _, s := range scores {
+		if _, ok := weights[s.CriterionId]; ok {
+			byBid[s.BidId] = append(byBid[s.BidId], s)
+		}
+	}
+
+	ranks := make([]BidRankOut, 0, len(bids))
+	for _, bid := range bids {
+		rank := BidRankOut{
+			Bid:    bid.ToOut(),
+			Scores: byBid[bid.Id],
+		}
+		if rank.Scores == nil {
+			rank.Scores = []CriterionScore{}
+		}
+
+		if total > 0 {
+			for _, s := range rank.Scores {
+				rank.Score += s.Average * float64(weights[s.CriterionId])
+			}
+			rank.Score /= float64(total)
+		}
+
+		ranks = append(ranks, rank)
+	}
+
+	slices.SortStableFunc(ranks, func(a, b BidRankOut) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	for i := range ranks {
+		ranks[i].Place = int32(i + 1)
+	}
+
+	return ranks
+}
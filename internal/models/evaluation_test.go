@@ -0,0 +1,91 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCriteriaNewInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"empty name", `{"criteria": [{"name": "", "type": "Price", "weight": 1}]}`, "criterion name must not be empty"},
+		{"unknown type", `{"criteria": [{"name": "n", "type": "Color", "weight": 1}]}`, "unknown criterion type"},
+		{"type not string", `{"criteria": [{"name": "n", "type": 1, "weight": 1}]}`, "criterion type must be a string"},
+		{"zero weight", `{"criteria": [{"name": "n", "type": "Price", "weight": 0}]}`, "criterion weight must be between 1 and 100"},
+		{"duplicate name", `{"criteria": [{"name": "n", "type": "Price", "weight": 1}, {"name": "n", "type": "Quality", "weight": 1}]}`, "criterion names must be unique"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var criteria CriteriaNew
+
+			err := json.Unmarshal([]byte(tt.json), &criteria)
+
+			var parseErr *Error
+			assert.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.want, parseErr.Response().Err)
+		})
+	}
+}
+
+func TestScoresNewInvalid(t *testing.T) {
+	id := "98abb192-f64d-44d6-9fcb-a2b0844c62bd"
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"empty", `{"scores": []}`, "scores must not be empty"},
+		{"out of range", `{"scores": [{"criterionId": "` + id + `", "score": 11}]}`, "score must be between 0 and 10"},
+		{"scored twice", `{"scores": [{"criterionId": "` + id + `", "score": 1}, {"criterionId": "` + id + `", "score": 2}]}`, "criterion must be scored once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var scores ScoresNew
+
+			err := json.Unmarshal([]byte(tt.json), &scores)
+
+			var parseErr *Error
+			assert.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.want, parseErr.Response().Err)
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	price := uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd")
+	quality := uuid.MustParse("002f9d2b-cd76-4921-8e53-21dbde75f993")
+	cheap := Bid{Id: uuid.MustParse("3fa85f64-5717-4562-b3fc-2c963f66afa6")}
+	good := Bid{Id: uuid.MustParse("0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c")}
+	unscored := Bid{Id: uuid.MustParse("5b3f2a6c-27a5-4c5f-8d7e-6a1f9a1c0b2d")}
+
+	criteria := []Criterion{
+		{Id: price, CriterionBase: CriterionBase{Weight: 3}},
+		{Id: quality, CriterionBase: CriterionBase{Weight: 1}},
+	}
+	scores := []CriterionScore{
+		{BidId: cheap.Id, CriterionId: price, Average: 8, Count: 2},
+		{BidId: cheap.Id, CriterionId: quality, Average: 4, Count: 2},
+		{BidId: good.Id, CriterionId: price, Average: 6, Count: 1},
+		{BidId: good.Id, CriterionId: quality, Average: 8, Count: 1},
+	}
+
+	// Bids come in price order, ranking reorders them by score.
+	ranks := Rank([]Bid{unscored, good, cheap}, criteria, scores)
+
+	assert.Len(t, ranks, 3)
+	assert.Equal(t, cheap.Id, ranks[0].Bid.Id)
+	assert.Equal(t, 7.0, ranks[0].Score)
+	assert.Equal(t, int32(1), ranks[0].Place)
+	assert.Equal(t, good.Id, ranks[1].Bid.Id)
+	assert.Equal(t, 6.5, ranks[1].Score)
+	assert.Equal(t, int32(2), ranks[1].Place)
+	assert.Equal(t, unscored.Id, ranks[2].Bid.Id)
+	assert.Equal(t, 0.0, ranks[2].Score)
+	assert.Equal(t, []CriterionScore{}, ranks[2].Scores)
+}
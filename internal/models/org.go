@@ -0,0 +1,221 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	valid "tender/internal/lib/validate"
+)
+
+type OrgBase struct {
+	Name string  `json:"name"`
+	Desc string  `json:"description"`
+	Type OrgType `json:"type"`
+}
+
+type OrgNew struct {
+	OrgBase
+}
+
+func (o *OrgNew) validate() error {
+	if err := valid.Validate(o.Name, "organization name", 100); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	if err := valid.Validate(string(o.Type), "organization type", 3); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	return nil
+}
+
+func (o *OrgNew) UnmarshalJSON(data []byte) error {
+	type _orgNew OrgNew
+
+	var tmp _orgNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	o.OrgBase = tmp.OrgBase
+
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *OrgNew) ToOrg() Org {
+	return Org{
+		OrgBase: o.OrgBase,
+		Quorum:  DefaultQuorum(),
+	}
+}
+
+type OrgPatch struct {
+	Name   *string  `json:"name"`
+	Desc   *string  `json:"description"`
+	Type   *OrgType `json:"type"`
+	Quorum *Quorum  `json:"quorum"`
+}
+
+func (o *OrgPatch) validate() error {
+	if o.Name != nil {
+		if err := valid.Validate(*o.Name, "organization name", 100); err != nil {
+			return NewParseError(err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (o *OrgPatch) UnmarshalJSON(data []byte) error {
+	type _orgPatch OrgPatch
+
+	var tmp _orgPatch
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	o.Name = tmp.Name
+	o.Desc = tmp.Desc
+	o.Type = tmp.Type
+	o.Quorum = tmp.Quorum
+
+	if err := o.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type OrgOut struct {
+	OrgBase
+	Quorum    Quorum    `json:"quorum"`
+	Id        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type Org struct {
+	OrgBase
+	Quorum    Quorum
+	Id        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (o *Org) ToOut() OrgOut {
+	return OrgOut{
+		OrgBase:   o.OrgBase,
+		Quorum:    o.Quorum,
+		Id:        o.Id,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+// Patch applies patch to organization.
+func (o *Org) Patch(patch OrgPatch) {
+	if patch.Name != nil {
+		o.Name = *patch.Name
+	}
+	if patch.Desc != nil {
+		o.Desc = *patch.Desc
+	}
+	if patch.Type != nil {
+		o.Type = *patch.Type
+	}
+	if patch.Quorum != nil {
+		o.Quorum = *patch.Quorum
+	}
+}
+
+type ResponsibleNew struct {
+	Username string `json:"username"`
+	Role     Role   `json:"role"`
+}
+
+func (r *ResponsibleNew) validate() error {
+	if err := valid.Validate(r.Username, "username", 50); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	if r.Role == "" {
+		r.Role = RoleViewer
+	}
+
+	return nil
+}
+
+func (r *ResponsibleNew) UnmarshalJSON(data []byte) error {
+	type _responsibleNew ResponsibleNew
+
+	var tmp _responsibleNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	r.Username = tmp.Username
+	r.Role = tmp.Role
+
+	if err := r.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResponsiblePatch changes responsible's role.
+type ResponsiblePatch struct {
+	Role Role `json:"role"`
+}
+
+func (r *ResponsiblePatch) validate() error {
+	if r.Role == "" {
+		return NewParseError("role is required")
+	}
+
+	return nil
+}
+
+func (r *ResponsiblePatch) UnmarshalJSON(data []byte) error {
+	type _responsiblePatch ResponsiblePatch
+
+	var tmp _responsiblePatch
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	r.Role = tmp.Role
+
+	if err := r.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Responsible is employee responsible for organization.
+type Responsible struct {
+	Employee
+	OrgId uuid.UUID
+	Role  Role
+}
+
+type ResponsibleOut struct {
+	EmployeeOut
+	OrgId uuid.UUID `json:"organizationId"`
+	Role  Role      `json:"role"`
+}
+
+func (r *Responsible) ToOut() ResponsibleOut {
+	return ResponsibleOut{
+		EmployeeOut: r.Employee.ToOut(),
+		OrgId:       r.OrgId,
+		Role:        r.Role,
+	}
+}
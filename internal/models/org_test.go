@@ -0,0 +1,54 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponsibleNewInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"empty username", `{"username": ""}`, "username must not be empty"},
+		{"unknown role", `{"username": "user", "role": "owner"}`, "unknown role"},
+		{"role not string", `{"username": "user", "role": 1}`, "role must be a string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var responsible ResponsibleNew
+
+			err := json.Unmarshal([]byte(tt.json), &responsible)
+
+			var parseErr *Error
+			assert.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.want, parseErr.Response().Err)
+		})
+	}
+}
+
+func TestResponsiblePatchInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"no role", `{}`, "role is required"},
+		{"unknown role", `{"role": "owner"}`, "unknown role"},
+		{"role not string", `{"role": 1}`, "role must be a string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch ResponsiblePatch
+
+			err := json.Unmarshal([]byte(tt.json), &patch)
+
+			var parseErr *Error
+			assert.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.want, parseErr.Response().Err)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	valid "tender/internal/lib/validate"
+
+	"github.com/google/uuid"
+)
+
+// Question is user's clarification question on tender.
+// Question is answered by tender's responsible, public answer
+// is visible to everyone, private one only to asker and responsibles.
+type Question struct {
+	Id        uuid.UUID
+	TenderId  uuid.UUID
+	UserId    uuid.UUID
+	Username  string
+	Text      string
+	CreatedAt time.Time
+	Answer    *Answer
+}
+
+type QuestionOut struct {
+	Id        uuid.UUID  `json:"id"`
+	Username  string     `json:"username,omitempty"`
+	Text      string     `json:"text"`
+	CreatedAt time.Time  `json:"createdAt"`
+	Answer    *AnswerOut `json:"answer,omitempty"`
+}
+
+func (q *Question) ToOut() QuestionOut {
+	out := QuestionOut{
+		Id:        q.Id,
+		Username:  q.Username,
+		Text:      q.Text,
+		CreatedAt: q.CreatedAt,
+	}
+	if q.Answer != nil {
+		answer := q.Answer.ToOut()
+		out.Answer = &answer
+	}
+
+	return out
+}
+
+type QuestionNew struct {
+	Text string `json:"text"`
+}
+
+func (q *QuestionNew) validate() error {
+	if err := valid.Validate(q.Text, "text", 1000); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	return nil
+}
+
+func (q *QuestionNew) UnmarshalJSON(data []byte) error {
+	type _questionNew QuestionNew
+
+	var tmp _questionNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	*q = QuestionNew(tmp)
+
+	if err := q.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (q *QuestionNew) ToQuestion(userId, tenderId uuid.UUID) Question {
+	return Question{
+		TenderId: tenderId,
+		UserId:   userId,
+		Text:     q.Text,
+	}
+}
+
+type Answer struct {
+	Id         uuid.UUID
+	QuestionId uuid.UUID
+	UserId     uuid.UUID
+	Username   string
+	Text       string
+	Public     bool
+	CreatedAt  time.Time
+}
+
+type AnswerOut struct {
+	Username  string    `json:"username"`
+	Text      string    `json:"text"`
+	Public    bool      `json:"public"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (a *Answer) ToOut() AnswerOut {
+	return AnswerOut{
+		Username:  a.Username,
+		Text:      a.Text,
+		Public:    a.Public,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+type AnswerNew struct {
+	Text   string `json:"text"`
+	Public bool   `json:"public"`
+}
+
+func (a *AnswerNew) validate() error {
+	if err := valid.Validate(a.Text, "text", 1000); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	return nil
+}
+
+func (a *AnswerNew) UnmarshalJSON(data []byte) error {
+	type _answerNew AnswerNew
+
+	var tmp _answerNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	*a = AnswerNew(tmp)
+
+	if err := a.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AnswerNew) ToAnswer(userId, questionId uuid.UUID) Answer {
+	return Answer{
+		QuestionId: questionId,
+		UserId:     userId,
+		Text:       a.Text,
+		Public:     a.Public,
+	}
+}
@@ -0,0 +1,97 @@
+package models
+
+import "encoding/json"
+
+// Quorum is organization's policy of deciding on bids.
+// Value is # of approvals for count quorum and
+// share of responsibles in percents for percent quorum.
+// If Veto is set, single rejection rejects bid.
+type Quorum struct {
+	Type  QuorumType `json:"type"`
+	Value int32      `json:"value,omitempty"`
+	Veto  bool       `json:"veto"`
+}
+
+// DefaultQuorum returns policy organizations have by default:
+// 3 approvals (or all responsibles if there are less of them),
+// any rejection is a veto.
+func DefaultQuorum() Quorum {
+	return Quorum{
+		Type:  QuorumCount,
+		Value: 3,
+		Veto:  true,
+	}
+}
+
+func (q *Quorum) validate() error {
+	switch q.Type {
+	case QuorumCount:
+		if q.Value < 1 {
+			return NewParseError("quorum count must be positive")
+		}
+	case QuorumPercent:
+		if q.Value < 1 || q.Value > 100 {
+			return NewParseError("quorum percent must be between 1 and 100")
+		}
+	case QuorumUnanimous:
+		q.Value = 0
+	default:
+		return NewParseError("quorum type must not be empty")
+	}
+
+	return nil
+}
+
+func (q *Quorum) UnmarshalJSON(data []byte) error {
+	type _quorum Quorum
+
+	var tmp _quorum
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	*q = Quorum(tmp)
+
+	if err := q.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Required returns # of approvals required
+// in organization with given # of responsibles.
+func (q Quorum) Required(size int64) int64 {
+	var required int64
+	switch q.Type {
+	case QuorumCount:
+		required = min(size, int64(q.Value))
+	case QuorumPercent:
+		// Round up, so that 50% of 3 is 2.
+		required = (size*int64(q.Value) + 99) / 100
+	default:
+		required = size
+	}
+
+	return max(required, 1)
+}
+
+// Decide returns summary decision by # of approvals and rejections
+// in organization with given # of responsibles.
+// Without veto bid is rejected when rejections reach quorum
+// or approvals can't reach it anymore.
+// Empty decision is returned if it is inconclusive yet.
+func (q Quorum) Decide(approvals, rejections, size int64) DecisionType {
+	required := q.Required(size)
+
+	switch {
+	case q.Veto && rejections > 0:
+		return Rejected
+	case approvals >= required:
+		return Approved
+	case rejections >= required, size-rejections < required:
+		return Rejected
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuorumDecide(t *testing.T) {
+	tests := []struct {
+		name       string
+		quorum     Quorum
+		approvals  int64
+		rejections int64
+		size       int64
+		want       DecisionType
+	}{
+		{"default approved", DefaultQuorum(), 3, 0, 5, Approved},
+		{"default small org", DefaultQuorum(), 2, 0, 2, Approved},
+		{"default veto", DefaultQuorum(), 3, 1, 5, Rejected},
+		{"count inconclusive", Quorum{Type: QuorumCount, Value: 2}, 1, 1, 5, ""},
+		{"percent rounds up", Quorum{Type: QuorumPercent, Value: 50}, 1, 0, 3, ""},
+		{"percent approved", Quorum{Type: QuorumPercent, Value: 50}, 2, 1, 3, Approved},
+		{"quorum unreachable", Quorum{Type: QuorumPercent, Value: 75}, 1, 2, 4, Rejected},
+		{"unanimous waits", Quorum{Type: QuorumUnanimous}, 2, 0, 3, ""},
+		{"unanimous approved", Quorum{Type: QuorumUnanimous}, 3, 0, 3, Approved},
+		{"unanimous rejected", Quorum{Type: QuorumUnanimous}, 2, 1, 3, Rejected},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quorum.Decide(tt.approvals, tt.rejections, tt.size))
+		})
+	}
+}
+
+func TestQuorumInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"unknown type", `{"type": "majority"}`, "unknown quorum type"},
+		{"type not string", `{"type": 1}`, "quorum type must be a string"},
+		{"zero count", `{"type": "count", "value": 0}`, "quorum count must be positive"},
+		{"percent too big", `{"type": "percent", "value": 120}`, "quorum percent must be between 1 and 100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch OrgPatch
+
+			err := json.Unmarshal([]byte(`{"quorum": `+tt.json+`}`), &patch)
+
+			var parseErr *Error
+			assert.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.want, parseErr.Response().Err)
+		})
+	}
+}
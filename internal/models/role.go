@@ -0,0 +1,58 @@
+package models
+
+import "slices"
+
+// Role of employee in organization.
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"
+	RoleEditor   Role = "editor"
+	RoleApprover Role = "approver"
+	RoleOrgAdmin Role = "org-admin"
+)
+
+// Action performed by organization's employee.
+type Action string
+
+const (
+	ActionView    Action = "view"
+	ActionEdit    Action = "edit"
+	ActionPublish Action = "publish"
+	ActionClose   Action = "close"
+	ActionApprove Action = "approve"
+	ActionReview  Action = "review"
+	ActionManage  Action = "manage"
+)
+
+// Permissions describes actions allowed to roles.
+type Permissions map[Role][]Action
+
+// NewPermissions returns permission matrix.
+// Viewer can only see organization's tenders and bids,
+// editor prepares and publishes them, approver decides
+// on bids and leaves reviews, org-admin can do everything.
+func NewPermissions() Permissions {
+	return Permissions{
+		RoleViewer:   {ActionView},
+		RoleEditor:   {ActionView, ActionEdit, ActionPublish, ActionClose},
+		RoleApprover: {ActionView, ActionApprove, ActionReview},
+		RoleOrgAdmin: {ActionView, ActionEdit, ActionPublish, ActionClose, ActionApprove, ActionReview, ActionManage},
+	}
+}
+
+// Roles returns roles allowed to perform action.
+func (p Permissions) Roles(action Action) []Role {
+	roles := make([]Role, 0, len(p))
+	for _, role := range []Role{RoleViewer, RoleEditor, RoleApprover, RoleOrgAdmin} {
+		if p.Allowed(role, action) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// Allowed checks if role is allowed to perform action.
+func (p Permissions) Allowed(role Role, action Action) bool {
+	return slices.Contains(p[role], action)
+}
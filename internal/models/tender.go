@@ -14,6 +14,71 @@ type TenderBase struct {
 	Name        string      `json:"name"`
 	Desc        string      `json:"description"`
 	ServiceType ServiceType `json:"serviceType"`
+	Deadline    *time.Time  `json:"deadline,omitempty"`
+	TenderTerms
+}
+
+// TenderTerms are procurement terms of tender.
+type TenderTerms struct {
+	// Budget ceiling in minor units of currency, e.g. cents.
+	Budget       *int64        `json:"budget,omitempty"`
+	Currency     *string       `json:"currency,omitempty"`
+	Quantity     *float64      `json:"quantity,omitempty"`
+	Unit         *string       `json:"unit,omitempty"`
+	Location     *string       `json:"location,omitempty"`
+	Requirements []Requirement `json:"requirements,omitempty"`
+}
+
+// Requirement is a condition bidder must satisfy.
+type Requirement struct {
+	Type RequirementType `json:"type"`
+	Desc string          `json:"description"`
+}
+
+func (t *TenderTerms) validate() error {
+	if (t.Budget == nil) != (t.Currency == nil) {
+		return NewParseError("budget and currency must be given together")
+	}
+
+	if t.Budget != nil && *t.Budget < 0 {
+		return NewParseError("budget must not be negative")
+	}
+
+	if t.Currency != nil {
+		if err := valid.Currency(*t.Currency); err != nil {
+			return NewParseError(err.Error())
+		}
+	}
+
+	if t.Quantity != nil && *t.Quantity <= 0 {
+		return NewParseError("quantity must be positive")
+	}
+
+	if t.Unit != nil {
+		if err := valid.Validate(*t.Unit, "unit", 20); err != nil {
+			return NewParseError(err.Error())
+		}
+	}
+
+	if t.Location != nil {
+		if err := valid.Validate(*t.Location, "location", 200); err != nil {
+			return NewParseError(err.Error())
+		}
+	}
+
+	if len(t.Requirements) > 50 {
+		return NewParseError("there must be no more than 50 requirements")
+	}
+	for _, req := range t.Requirements {
+		if req.Type == "" {
+			return NewParseError("requirement type must not be empty")
+		}
+		if err := valid.Validate(req.Desc, "requirement description", 500); err != nil {
+			return NewParseError(err.Error())
+		}
+	}
+
+	return nil
 }
 
 type TenderNew struct {
@@ -34,6 +99,14 @@ func (t *TenderNew) validate() error {
 		return NewParseError("description must not be longer than 100 characters")
 	}
 
+	if t.Deadline != nil && !t.Deadline.After(time.Now()) {
+		return NewParseError("deadline must be in the future")
+	}
+
+	if err := t.TenderTerms.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -68,6 +141,8 @@ type TenderPatch struct {
 	Name        *string      `json:"name"`
 	Desc        *string      `json:"description"`
 	ServiceType *ServiceType `json:"serviceType"`
+	Deadline    *time.Time   `json:"deadline"`
+	TenderTerms
 }
 
 func (t *TenderPatch) validate() error {
@@ -79,6 +154,14 @@ func (t *TenderPatch) validate() error {
 		return NewParseError("description must not be longer than 100 characters")
 	}
 
+	if t.Deadline != nil && !t.Deadline.After(time.Now()) {
+		return NewParseError("deadline must be in the future")
+	}
+
+	if err := t.TenderTerms.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -87,6 +170,8 @@ func (t *TenderPatch) UnmarshalJSON(data []byte) error {
 		Name        *string      `json:"name"`
 		Desc        *string      `json:"description"`
 		ServiceType *ServiceType `json:"serviceType"`
+		Deadline    *time.Time   `json:"deadline"`
+		TenderTerms
 	}
 
 	var tmp _tenderPatch
@@ -97,6 +182,8 @@ func (t *TenderPatch) UnmarshalJSON(data []byte) error {
 	t.Desc = tmp.Desc
 	t.Name = tmp.Name
 	t.ServiceType = tmp.ServiceType
+	t.Deadline = tmp.Deadline
+	t.TenderTerms = tmp.TenderTerms
 
 	if err := t.validate(); err != nil {
 		return err
@@ -107,10 +194,11 @@ func (t *TenderPatch) UnmarshalJSON(data []byte) error {
 
 type TenderOut struct {
 	TenderBase
-	Id        uuid.UUID    `json:"id"`
-	Status    TenderStatus `json:"status"`
-	Version   int32        `json:"version"`
-	CreatedAt time.Time    `json:"createdAt"`
+	Id           uuid.UUID      `json:"id"`
+	Status       TenderStatus   `json:"status"`
+	NextStatuses []TenderStatus `json:"nextStatuses,omitempty"`
+	Version      int32          `json:"version"`
+	CreatedAt    time.Time      `json:"createdAt"`
 }
 
 type Tender struct {
@@ -142,4 +230,54 @@ func (t *Tender) Patch(patch TenderPatch) {
 	if patch.ServiceType != nil {
 		t.ServiceType = *patch.ServiceType
 	}
+	if patch.Deadline != nil {
+		t.Deadline = patch.Deadline
+	}
+	if patch.Budget != nil {
+		t.Budget = patch.Budget
+		t.Currency = patch.Currency
+	}
+	if patch.Quantity != nil {
+		t.Quantity = patch.Quantity
+	}
+	if patch.Unit != nil {
+		t.Unit = patch.Unit
+	}
+	if patch.Location != nil {
+		t.Location = patch.Location
+	}
+	if patch.Requirements != nil {
+		t.Requirements = patch.Requirements
+	}
+}
+
+// Overdue checks if tender's deadline has passed at given moment.
+// Tender without deadline is never overdue.
+func (t *Tender) Overdue(now time.Time) bool {
+	return t.Deadline != nil && !now.Before(*t.Deadline)
+}
+
+// TenderFilter restricts and orders tenders list.
+// Zero value matches all published tenders sorted by name.
+type TenderFilter struct {
+	OrgId        *uuid.UUID
+	Statuses     []TenderStatus
+	ServiceTypes []ServiceType
+	// Creation time range, From is inclusive, To is exclusive.
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Version     *int32
+	// Full-text search query.
+	Query string
+	Sort  TenderSort
+}
+
+// Public checks if filter matches only published tenders.
+func (f *TenderFilter) Public() bool {
+	for _, st := range f.Statuses {
+		if st != TenderPublished {
+			return false
+		}
+	}
+	return true
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	ptr "tender/internal/lib/utils/pointers"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,93 @@ func TestTenderNew(t *testing.T) {
 	assert.Equal(t, expect, tender)
 }
 
+func TestTenderNewDeadline(t *testing.T) {
+	s := `{
+		"name": "some name",
+		"serviceType": "Construction",
+		"organizationId": "002f9d2b-cd76-4921-8e53-21dbde75f993",
+		"creatorUsername": "user",
+		"deadline": "2000-01-01T00:00:00Z"
+	}`
+
+	var tender TenderNew
+
+	err := json.Unmarshal([]byte(s), &tender)
+
+	var parseErr *Error
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "deadline must be in the future", parseErr.Response().Err)
+}
+
+func TestTenderNewTerms(t *testing.T) {
+	s := `{
+		"name": "some name",
+		"serviceType": "Delivery",
+		"organizationId": "002f9d2b-cd76-4921-8e53-21dbde75f993",
+		"creatorUsername": "user",
+		"budget": 500000,
+		"currency": "RUB",
+		"quantity": 12.5,
+		"unit": "t",
+		"location": "Moscow",
+		"requirements": [{"type": "License", "description": "transport license"}]
+	}`
+	expect := TenderTerms{
+		Budget:   ptr.Ptr(int64(500000)),
+		Currency: ptr.Ptr("RUB"),
+		Quantity: ptr.Ptr(12.5),
+		Unit:     ptr.Ptr("t"),
+		Location: ptr.Ptr("Moscow"),
+		Requirements: []Requirement{
+			{Type: RequirementLicense, Desc: "transport license"},
+		},
+	}
+
+	var tender TenderNew
+
+	err := json.Unmarshal([]byte(s), &tender)
+	assert.NoError(t, err)
+	assert.Equal(t, expect, tender.TenderTerms)
+}
+
+func TestTenderTermsInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want string
+	}{
+		{"budget without currency", `{"budget": 100}`, "budget and currency must be given together"},
+		{"negative budget", `{"budget": -1, "currency": "USD"}`, "budget must not be negative"},
+		{"zero quantity", `{"quantity": 0}`, "quantity must be positive"},
+		{"empty requirement type", `{"requirements": [{"description": "d"}]}`, "requirement type must not be empty"},
+		{"requirement type not string", `{"requirements": [{"type": 1, "description": "d"}]}`, "requirement type must be a string"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patch TenderPatch
+
+			err := json.Unmarshal([]byte(tt.json), &patch)
+
+			var parseErr *Error
+			assert.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.want, parseErr.Response().Err)
+		})
+	}
+}
+
+func TestTenderOverdue(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	tender := Tender{}
+	assert.False(t, tender.Overdue(now))
+
+	tender.Deadline = ptr.Ptr(now.Add(time.Minute))
+	assert.False(t, tender.Overdue(now))
+
+	tender.Deadline = ptr.Ptr(now)
+	assert.True(t, tender.Overdue(now))
+}
+
 func TestTenderPatch(t *testing.T) {
 	s := `{
 		"name" : "new name",
@@ -51,3 +139,69 @@ func TestTenderPatch(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expect, patch)
 }
+
+func TestTenderDiff(t *testing.T) {
+	from := Tender{
+		TenderBase: TenderBase{Name: "name", Desc: "old description", ServiceType: Construction},
+		Status:     TenderCreated,
+		Version:    2,
+	}
+	to := Tender{
+		TenderBase: TenderBase{Name: "name", Desc: "new description", ServiceType: Construction},
+		Status:     TenderPublished,
+		Version:    5,
+	}
+	expect := Diff{
+		From: 2,
+		To:   5,
+		Changes: []FieldDiff{
+			{Field: "description", From: "old description", To: "new description"},
+			{Field: "status", From: TenderCreated, To: TenderPublished},
+		},
+	}
+
+	assert.Equal(t, expect, TenderDiff(from, to))
+}
+
+func TestTenderDiffTerms(t *testing.T) {
+	from := Tender{
+		TenderBase: TenderBase{TenderTerms: TenderTerms{
+			Budget:   ptr.Ptr(int64(500000)),
+			Currency: ptr.Ptr("RUB"),
+			Location: ptr.Ptr("Moscow"),
+			Requirements: []Requirement{
+				{Type: RequirementLicense, Desc: "building license"},
+			},
+		}},
+		Version: 1,
+	}
+	// Equal values behind different pointers are not changes.
+	to := Tender{
+		TenderBase: TenderBase{TenderTerms: TenderTerms{
+			Budget:   ptr.Ptr(int64(500000)),
+			Currency: ptr.Ptr("RUB"),
+			Quantity: ptr.Ptr(2.5),
+			Unit:     ptr.Ptr("t"),
+			Location: ptr.Ptr("Moscow"),
+			Requirements: []Requirement{
+				{Type: RequirementLicense, Desc: "building license"},
+				{Type: RequirementExperience, Desc: "5 years"},
+			},
+		}},
+		Version: 2,
+	}
+	expect := Diff{
+		From: 1,
+		To:   2,
+		Changes: []FieldDiff{
+			{Field: "quantity", From: nil, To: 2.5},
+			{Field: "unit", From: nil, To: "t"},
+			{Field: "requirements", From: from.Requirements, To: to.Requirements},
+		},
+	}
+
+	assert.Equal(t, expect, TenderDiff(from, to))
+
+	// Missing and empty requirements are the same.
+	assert.Empty(t, TenderDiff(Tender{}, Tender{TenderBase: TenderBase{TenderTerms: TenderTerms{Requirements: []Requirement{}}}}).Changes)
+}
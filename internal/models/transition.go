@@ -0,0 +1,82 @@
+package models
+
+import "slices"
+
+// Transitions describes allowed status changes.
+// Key is current status, value is list of statuses
+// that can be set next.
+type Transitions[S ~string] map[S][]S
+
+type TenderTransitions = Transitions[TenderStatus]
+type BidTransitions = Transitions[BidStatus]
+
+// NewTenderTransitions returns tender state machine
+// Created -> Published -> Closed.
+// If reopen is set, closed tender can be published again.
+func NewTenderTransitions(reopen bool) TenderTransitions {
+	tr := TenderTransitions{
+		TenderCreated:   {TenderPublished},
+		TenderPublished: {TenderClosed},
+		TenderClosed:    {},
+	}
+
+	if reopen {
+		tr[TenderClosed] = append(tr[TenderClosed], TenderPublished)
+	}
+
+	return tr
+}
+
+// NewBidTransitions returns bid state machine
+// for changes made by bid's author.
+// Draft bid can be published or canceled,
+// published bid can be canceled.
+// Withdrawal has its own state machine as it requires reason.
+func NewBidTransitions() BidTransitions {
+	return BidTransitions{
+		BidCreated:   {BidPublished, BidCanceled},
+		BidPublished: {BidCanceled},
+		BidCanceled:  {},
+		BidWithdrawn: {},
+		BidApproved:  {},
+		BidRejected:  {},
+	}
+}
+
+// NewBidDecisionTransitions returns bid state machine
+// for changes made by tender's responsibles decisions.
+// Only published bid can be approved or rejected.
+func NewBidDecisionTransitions() BidTransitions {
+	return BidTransitions{
+		BidPublished: {BidApproved, BidRejected},
+	}
+}
+
+// NewBidWithdrawTransitions returns bid state machine
+// for withdrawal by bid's author.
+// Only published bid can be withdrawn.
+func NewBidWithdrawTransitions() BidTransitions {
+	return BidTransitions{
+		BidPublished: {BidWithdrawn},
+	}
+}
+
+// NewBidResubmitTransitions returns bid state machine
+// for resubmission by bid's author.
+// Withdrawn or canceled bid can be published again.
+func NewBidResubmitTransitions() BidTransitions {
+	return BidTransitions{
+		BidWithdrawn: {BidPublished},
+		BidCanceled:  {BidPublished},
+	}
+}
+
+// Allowed checks if status can be changed from one to another.
+func (tr Transitions[S]) Allowed(from, to S) bool {
+	return slices.Contains(tr[from], to)
+}
+
+// Next returns statuses allowed after given one.
+func (tr Transitions[S]) Next(from S) []S {
+	return slices.Clone(tr[from])
+}
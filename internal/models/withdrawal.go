@@ -0,0 +1,81 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	valid "tender/internal/lib/validate"
+
+	"github.com/google/uuid"
+)
+
+// Withdrawal is author's reasoned withdrawal of published bid.
+// ResubmittedAt is set once bid is published again.
+type Withdrawal struct {
+	Id            uuid.UUID
+	BidId         uuid.UUID
+	UserId        uuid.UUID
+	Reason        string
+	WithdrawnAt   time.Time
+	ResubmittedAt *time.Time
+}
+
+type WithdrawalOut struct {
+	Id            uuid.UUID  `json:"id"`
+	Reason        string     `json:"reason"`
+	WithdrawnAt   time.Time  `json:"withdrawnAt"`
+	ResubmittedAt *time.Time `json:"resubmittedAt,omitempty"`
+}
+
+func (w *Withdrawal) ToOut() WithdrawalOut {
+	return WithdrawalOut{
+		Id:            w.Id,
+		Reason:        w.Reason,
+		WithdrawnAt:   w.WithdrawnAt,
+		ResubmittedAt: w.ResubmittedAt,
+	}
+}
+
+type WithdrawalNew struct {
+	Reason string `json:"reason"`
+}
+
+func (w *WithdrawalNew) validate() error {
+	if err := valid.Validate(w.Reason, "reason", 1000); err != nil {
+		return NewParseError(err.Error())
+	}
+
+	return nil
+}
+
+func (w *WithdrawalNew) UnmarshalJSON(data []byte) error {
+	type _withdrawalNew WithdrawalNew
+
+	var tmp _withdrawalNew
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	*w = WithdrawalNew(tmp)
+
+	if err := w.validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (w *WithdrawalNew) ToWithdrawal(userId, bidId uuid.UUID) Withdrawal {
+	return Withdrawal{
+		BidId:  bidId,
+		UserId: userId,
+		Reason: w.Reason,
+	}
+}
+
+// BidWithdrawalOut is bid after withdrawal or resubmission.
+// Withdrawal is omitted for bid resubmitted after cancelling.
+type BidWithdrawalOut struct {
+	BidOut
+	Withdrawal *WithdrawalOut `json:"withdrawal,omitempty"`
+}
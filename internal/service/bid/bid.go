@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
+
 	"tender/internal/lib/logger/sl"
 	"tender/internal/models"
 	"tender/internal/service"
@@ -14,11 +16,20 @@ import (
 )
 
 type Bid struct {
-	log         *slog.Logger
-	userSrv     UserService
-	tenderSrv   TenderService
-	rollbackSrv RollbackService
-	bidStorage  BidStorage
+	log                 *slog.Logger
+	userSrv             UserService
+	tenderSrv           TenderService
+	rollbackSrv         RollbackService
+	bidStorage          BidStorage
+	transitions         models.BidTransitions
+	decisionTransitions models.BidTransitions
+	withdrawTransitions models.BidTransitions
+	resubmitTransitions models.BidTransitions
+	// If set, bid's price must not exceed tender's budget.
+	strictBudget bool
+	// If set, decisions are carried over to new version of bid,
+	// otherwise bid needs to be decided again after every change.
+	keepDecisions bool
 }
 
 func New(
@@ -27,29 +38,39 @@ func New(
 	tenderSrv TenderService,
 	rollbackSrv RollbackService,
 	bidStorage BidStorage,
+	strictBudget bool,
+	keepDecisions bool,
 ) *Bid {
 	return &Bid{
-		log:         log,
-		userSrv:     userSrv,
-		tenderSrv:   tenderSrv,
-		rollbackSrv: rollbackSrv,
-		bidStorage:  bidStorage,
+		log:                 log,
+		userSrv:             userSrv,
+		tenderSrv:           tenderSrv,
+		rollbackSrv:         rollbackSrv,
+		bidStorage:          bidStorage,
+		transitions:         models.NewBidTransitions(),
+		decisionTransitions: models.NewBidDecisionTransitions(),
+		withdrawTransitions: models.NewBidWithdrawTransitions(),
+		resubmitTransitions: models.NewBidResubmitTransitions(),
+		strictBudget:        strictBudget,
+		keepDecisions:       keepDecisions,
 	}
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name UserService
 type UserService interface {
+	Caller(ctx context.Context) (string, error)
 	Validate(ctx context.Context, username string) error
 	ValidateUserId(ctx context.Context, userId uuid.UUID) error
 	ValidateOrgId(ctx context.Context, orgId uuid.UUID) error
 	UserId(ctx context.Context, username string) (uuid.UUID, error)
-	Permission(ctx context.Context, username string, orgId uuid.UUID) error
+	Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error
 	OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error)
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name TenderService
 type TenderService interface {
 	Tender(ctx context.Context, id uuid.UUID) (models.Tender, error)
+	Close(ctx context.Context, id uuid.UUID) (models.Tender, error)
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name RollbackService
@@ -57,6 +78,8 @@ type RollbackService interface {
 	SaveBid(ctx context.Context, bid models.Bid) error
 	// Save outdated bid and recover old bid.
 	SwapBid(ctx context.Context, bidId uuid.UUID, version int32, outdatedBid models.Bid) (models.Bid, error)
+	BidVersions(ctx context.Context, bidId uuid.UUID, limit, offset int32) ([]models.Bid, error)
+	BidVersion(ctx context.Context, bidId uuid.UUID, version int32) (models.Bid, error)
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name BidStorage
@@ -67,21 +90,25 @@ type BidStorage interface {
 
 	InsertBid(ctx context.Context, bid models.Bid) (models.Bid, error)
 	Bid(ctx context.Context, bidId uuid.UUID) (models.Bid, error)
-	UpdateBid(ctx context.Context, bid models.Bid) error
-	TenderBids(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.Bid, error)
-	UserBids(ctx context.Context, username string, limit, offset int32) ([]models.Bid, error)
+	UpdateBid(ctx context.Context, bid models.Bid, version int32) error
+	TenderBids(ctx context.Context, tenderId uuid.UUID, viewer, query string, limit, offset int32, after *models.Cursor) ([]models.Bid, *models.Cursor, error)
+	UserBids(ctx context.Context, username string, limit, offset int32, after *models.Cursor) ([]models.Bid, *models.Cursor, error)
+	PublishedBidsByPrice(ctx context.Context, tenderId uuid.UUID) ([]models.Bid, error)
 	BidSetStatus(ctx context.Context, bidId uuid.UUID, status models.BidStatus) (models.Bid, error)
+	TenderBidsSetStatus(ctx context.Context, tenderId, exceptBidId uuid.UUID, status models.BidStatus) error
 
 	InsertReview(ctx context.Context, review models.Review) (uuid.UUID, error)
-	Reviews(ctx context.Context, tenderId uuid.UUID, author string, limit, offset int32) ([]models.Review, error)
+	Reviews(ctx context.Context, tenderId uuid.UUID, author string, limit, offset int32, after *models.Cursor) ([]models.Review, *models.Cursor, error)
 
 	InsertDecision(ctx context.Context, decision models.Decision) error
-	Decisions(ctx context.Context, bidId uuid.UUID) ([]models.Decision, error)
-}
+	Decisions(ctx context.Context, bidId uuid.UUID, version int32) ([]models.Decision, error)
+	CarryOverDecisions(ctx context.Context, bidId uuid.UUID, from, to int32) error
+	DecisionLog(ctx context.Context, bidId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.Decision, *models.Cursor, error)
+	OrgQuorum(ctx context.Context, orgId uuid.UUID) (models.Quorum, error)
 
-const (
-	QUORUM_SIZE = 3
-)
+	InsertWithdrawal(ctx context.Context, withdrawal models.Withdrawal) (models.Withdrawal, error)
+	ResubmitWithdrawal(ctx context.Context, bidId uuid.UUID) (models.Withdrawal, error)
+}
 
 // New inserts new bid.
 func (b *Bid) New(ctx context.Context, bidNew models.BidNew) (models.BidOut, error) {
@@ -90,6 +117,7 @@ func (b *Bid) New(ctx context.Context, bidNew models.BidNew) (models.BidOut, err
 	log := b.log.With(
 		slog.String("op", op),
 		slog.String("creator", bidNew.AuthorId.String()),
+		slog.String("tender id", bidNew.TenderId.String()),
 	)
 
 	ctx, err := b.bidStorage.Begin(ctx)
@@ -106,17 +134,38 @@ func (b *Bid) New(ctx context.Context, bidNew models.BidNew) (models.BidOut, err
 	// Create bid with version=1.
 	bid := bidNew.ToBid()
 
-	// Check if user/org exists.
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.BidOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Check if user/org exists and user is allowed to bid on its behalf.
 	switch bidNew.AuthorType {
 	case models.User:
 		if err := b.userSrv.ValidateUserId(ctx, bidNew.AuthorId); err != nil {
 			if errors.Is(err, service.ErrUserNotFound) {
-				log.Warn("user not found")
-				return models.BidOut{}, service.ErrUserNotFound
+				log.Warn("author not found")
+				return models.BidOut{}, service.ErrAuthorNotFound
 			}
 			log.Error("failed to verify user", sl.Err(err))
 			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 		}
+		userId, err := b.userSrv.UserId(ctx, username)
+		if err != nil {
+			log.Error("failed to get user's id", sl.Err(err))
+			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if userId != bidNew.AuthorId {
+			log.Warn("user not allowed to bid on behalf of other user")
+			return models.BidOut{}, service.ErrNotEnoughPrivileges
+		}
 	case models.Organization:
 		if err := b.userSrv.ValidateOrgId(ctx, bidNew.AuthorId); err != nil {
 			if errors.Is(err, service.ErrOrganizationNotFound) {
@@ -126,6 +175,41 @@ func (b *Bid) New(ctx context.Context, bidNew models.BidNew) (models.BidOut, err
 			log.Error("failed to verify organization", sl.Err(err))
 			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 		}
+		if err := b.userSrv.Permission(ctx, username, bidNew.AuthorId, models.ActionEdit); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				log.Warn("user not allowed to bid on behalf of organization")
+				return models.BidOut{}, err
+			}
+			log.Error("failed to check user permission", sl.Err(err))
+			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Get bid's tender.
+	tender, err := b.tenderSrv.Tender(ctx, bidNew.TenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.BidOut{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if tender accepts bids.
+	if tender.Status != models.TenderPublished {
+		log.Warn("tender is not published", slog.String("tender status", string(tender.Status)))
+		return models.BidOut{}, service.ErrTenderNotPublished
+	}
+	if tender.Overdue(time.Now()) {
+		log.Warn("tender deadline has passed")
+		return models.BidOut{}, service.ErrDeadlinePassed
+	}
+
+	// Check if price fits tender's budget.
+	if err := b.budget(tender, bid.BidTerms); err != nil {
+		log.Warn("bid price doesn't fit tender budget", sl.Err(err))
+		return models.BidOut{}, err
 	}
 
 	// Insert bid.
@@ -143,14 +227,15 @@ func (b *Bid) New(ctx context.Context, bidNew models.BidNew) (models.BidOut, err
 	return bid.ToOut(), nil
 }
 
-// SubmitDecision submits decision.
-// Closes bid.
-func (b *Bid) SubmitDecision(ctx context.Context, username string, bidId uuid.UUID, decision models.DecisionType) (models.BidOut, error) {
+// SubmitDecision appends decision with comment to bid's decision log.
+// If bid is approved by quorum, closes its tender
+// and rejects competing bids.
+// Returns bid with the current tally of decisions.
+func (b *Bid) SubmitDecision(ctx context.Context, bidId uuid.UUID, decision models.DecisionType, comment string) (models.BidDecisionOut, error) {
 	const op = "Bid.SubmitDecision"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("bid id", bidId.String()),
 		slog.String("decision", string(decision)),
 	)
@@ -158,7 +243,7 @@ func (b *Bid) SubmitDecision(ctx context.Context, username string, bidId uuid.UU
 	ctx, err := b.bidStorage.Begin(ctx)
 	if err != nil {
 		log.Error("failed to start tx", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 	defer func() {
 		if err := b.bidStorage.Rollback(ctx); err != nil {
@@ -166,25 +251,27 @@ func (b *Bid) SubmitDecision(ctx context.Context, username string, bidId uuid.UU
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
-			return models.BidOut{}, err
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.BidDecisionOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		log.Error("failed to get caller", sl.Err(err))
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get bid.
 	bid, err := b.bidStorage.Bid(ctx, bidId)
 	if err != nil {
 		if errors.Is(err, storage.ErrBidNotFound) {
 			log.Warn("tender not found")
-			return models.BidOut{}, service.ErrBidNotFound
+			return models.BidDecisionOut{}, service.ErrBidNotFound
 		}
 		log.Error("failed to get tender", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Get bid's tender
@@ -192,20 +279,30 @@ func (b *Bid) SubmitDecision(ctx context.Context, username string, bidId uuid.UU
 	if err != nil {
 		if errors.Is(err, service.ErrTenderNotFound) {
 			log.Warn("tender not found")
-			return models.BidOut{}, service.ErrTenderNotFound
+			return models.BidDecisionOut{}, service.ErrTenderNotFound
 		}
 		log.Error("failed to get tender", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Check if user is allowed to modify tender info.
-	if err := b.userSrv.Permission(ctx, username, tender.OrgId); err != nil {
+	if err := b.userSrv.Permission(ctx, username, tender.OrgId, models.ActionApprove); err != nil {
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
 			log.Warn("user not allowed")
-			return models.BidOut{}, service.ErrNotEnoughPrivileges
+			return models.BidDecisionOut{}, err
 		}
 		log.Error("failed to check permission", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if tender and bid are open for decisions.
+	if tender.Status != models.TenderPublished {
+		log.Warn("tender is not published", slog.String("tender status", string(tender.Status)))
+		return models.BidDecisionOut{}, service.ErrTenderNotPublished
+	}
+	if bid.Status != models.BidPublished {
+		log.Warn("bid is not published", slog.String("current status", string(bid.Status)))
+		return models.BidDecisionOut{}, service.ErrBidNotPublished
 	}
 
 	// Get user id.
@@ -213,93 +310,299 @@ func (b *Bid) SubmitDecision(ctx context.Context, username string, bidId uuid.UU
 	if err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			log.Warn("user not found")
-			return models.BidOut{}, service.ErrUserNotFound
+			return models.BidDecisionOut{}, service.ErrUserNotFound
 		}
 		log.Error("failed to get user id")
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user is not deciding on own bid.
+	if err := service.OwnBid(ctx, b.userSrv, username, userId, bid); err != nil {
+		if errors.Is(err, service.ErrConflictOfInterest) {
+			log.Warn("user is author of bid")
+			return models.BidDecisionOut{}, err
+		}
+		log.Error("failed to check bid's author", sl.Err(err))
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if bid can be decided.
+	if !b.decisionTransitions.Allowed(bid.Status, decisionStatus(decision)) {
+		log.Warn("invalid status transition", slog.String("current status", string(bid.Status)))
+		return models.BidDecisionOut{}, service.ErrInvalidTransition
 	}
 
 	// Save decision.
 	if err := b.bidStorage.InsertDecision(ctx, models.Decision{
-		UserId:   userId,
-		BidId:    bid.Id,
-		Decision: decision,
+		UserId:     userId,
+		BidId:      bid.Id,
+		BidVersion: bid.Version,
+		Decision:   decision,
+		Comment:    comment,
 	}); err != nil {
 		log.Error("failed to insert decision")
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Get all decisions for bid.
-	decisions, err := b.bidStorage.Decisions(ctx, bidId)
+	// Get decisions made on current version of bid.
+	decisions, err := b.bidStorage.Decisions(ctx, bidId, bid.Version)
 	if err != nil {
 		log.Error("failed to get bid's decision", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Get organization size.
+	// Get # of org members who can decide on bids.
 	orgSize, err := b.userSrv.OrgSize(ctx, tender.OrgId)
 	if err != nil {
 		if errors.Is(err, service.ErrOrganizationNotFound) {
 			log.Warn("org not found")
-			return models.BidOut{}, service.ErrOrganizationNotFound
+			return models.BidDecisionOut{}, service.ErrOrganizationNotFound
 		}
 		log.Error("failed to get org size", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Determine minimum required approves.
-	required_approves := min(orgSize, QUORUM_SIZE)
+	// Get organization's quorum policy.
+	quorum, err := b.bidStorage.OrgQuorum(ctx, tender.OrgId)
+	if err != nil {
+		if errors.Is(err, storage.ErrOrgNotFound) {
+			log.Warn("org not found")
+			return models.BidDecisionOut{}, service.ErrOrganizationNotFound
+		}
+		log.Error("failed to get org quorum", sl.Err(err))
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
 
 	// Summary decision.
-	summary := models.DecisionType("null")
-	approve_counter := 0
-loop:
-	for _, d := range decisions {
-		switch d.Decision {
-		case models.Approved:
-			approve_counter++
-		case models.Rejected:
-			summary = models.Rejected
-			break loop
+	tally := models.NewTally(decisions, quorum, orgSize)
+	summary := quorum.Decide(tally.Approvals, tally.Rejections, orgSize)
+
+	// Check if decision was conclusive or not.
+	if summary == "" {
+		log.Info("inconclusive decision")
+
+		if err := b.bidStorage.Commit(ctx); err != nil {
+			log.Error("failed to commit", sl.Err(err))
+			return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 		}
+
+		return models.BidDecisionOut{BidOut: bid.ToOut(), Tally: tally}, nil
 	}
-	if summary != models.Rejected && approve_counter >= int(required_approves) {
-		summary = models.Approved
+	log.Info("conclusive decision", slog.String("decision", string(summary)))
+
+	// Set bid status according to summary decision.
+	bid.Status = decisionStatus(summary)
+	if err := b.bidStorage.UpdateBid(ctx, bid, bid.Version); err != nil {
+		if errors.Is(err, storage.ErrVersionConflict) {
+			log.Warn("bid was modified concurrently")
+			return models.BidDecisionOut{}, service.ErrVersionConflict
+		}
+		log.Error("failed to update bid status", sl.Err(err))
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// check if decision wac conclusive or not.
-	if summary == models.DecisionType("null") {
-		log.Info("inconclusive decision")
-		return bid.ToOut(), nil
+	if summary == models.Approved {
+		// Close tender.
+		if _, err := b.tenderSrv.Close(ctx, tender.Id); err != nil {
+			if errors.Is(err, service.ErrTenderNotFound) || errors.Is(err, service.ErrInvalidTransition) {
+				log.Warn("tender can't be closed", sl.Err(err))
+				return models.BidDecisionOut{}, err
+			}
+			log.Error("failed to close tender", sl.Err(err))
+			return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Reject competing published bids.
+		if err := b.bidStorage.TenderBidsSetStatus(ctx, tender.Id, bid.Id, models.BidRejected); err != nil {
+			log.Error("failed to reject competing bids", sl.Err(err))
+			return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
+		}
 	}
-	log.Info("conclusive decision", slog.String("decision", string(summary)))
 
-	// Set bid status to cancel if it was rejected or approved by quorum.
-	bid.Status = models.BidCanceled
-	if err := b.bidStorage.UpdateBid(ctx, bid); err != nil {
-		log.Error("failed to update bid status")
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	if err := b.bidStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.BidDecisionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return models.BidDecisionOut{BidOut: bid.ToOut(), Tally: tally}, nil
+}
+
+// Decisions returns bid's decision log, earliest first.
+// Log is visible only to tender's responsibles.
+// Returns cursor of the next page if there is one.
+func (b *Bid) Decisions(ctx context.Context, bidId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.DecisionOut, *models.Cursor, error) {
+	const op = "Bid.Decisions"
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("bid id", bidId.String()),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	ctx, err := b.bidStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := b.bidStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get bid.
+	bid, err := b.bidStorage.Bid(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, storage.ErrBidNotFound) {
+			log.Warn("bid not found")
+			return nil, nil, service.ErrBidNotFound
+		}
+		log.Error("failed to get bid", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get bid's tender
+	tender, err := b.tenderSrv.Tender(ctx, bid.TenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return nil, nil, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user is tender's responsible.
+	if err := b.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("user not allowed")
+			return nil, nil, err
+		}
+		log.Error("failed to check permission", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get decisions.
+	decisions, next, err := b.bidStorage.DecisionLog(ctx, bidId, limit, offset, after)
+	if err != nil {
+		log.Error("failed to get decision log", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := b.bidStorage.Commit(ctx); err != nil {
 		log.Error("failed to commit", sl.Err(err))
-		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return bid.ToOut(), nil
+	// Convert slice elements.
+	res := make([]models.DecisionOut, 0, len(decisions))
+	for _, d := range decisions {
+		res = append(res, d.ToOut())
+	}
+
+	return res, next, nil
 }
 
 // List returns bids related to tender.
-func (b *Bid) List(ctx context.Context, username string, tenderId uuid.UUID, limit, offset int32) ([]models.BidOut, error) {
+// If query is given, only bids matching it are returned, most relevant first.
+// Returns cursor of the next page if there is one.
+func (b *Bid) List(ctx context.Context, tenderId uuid.UUID, query string, limit, offset int32, after *models.Cursor) ([]models.BidOut, *models.Cursor, error) {
 	const op = "Bid.List"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
+		slog.String("query", query),
 		slog.Int("limit", int(limit)),
 		slog.Int("offset", int(offset)),
 	)
 
+	ctx, err := b.bidStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := b.bidStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := b.tenderSrv.Tender(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return nil, nil, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Tender's responsibles see all bids, other users only public and own ones.
+	viewer := ""
+	if err := b.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if !errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Error("failed to check user permission", sl.Err(err))
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		viewer = username
+	}
+
+	// Get tender's bids.
+	res, next, err := b.bidStorage.TenderBids(ctx, tenderId, viewer, query, limit, offset, after)
+	if err != nil {
+		log.Error("failed to get tender's bids", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	out := make([]models.BidOut, 0, len(res))
+	for i := range res {
+		out = append(out, res[i].ToOut())
+	}
+
+	if err := b.bidStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, next, nil
+}
+
+// Compare returns tender's published bids sorted by price.
+func (b *Bid) Compare(ctx context.Context, tenderId uuid.UUID) ([]models.BidOut, error) {
+	const op = "Bid.Compare"
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("tender id", tenderId.String()),
+	)
+
 	ctx, err := b.bidStorage.Begin(ctx)
 	if err != nil {
 		log.Error("failed to start tx", sl.Err(err))
@@ -311,15 +614,17 @@ func (b *Bid) List(ctx context.Context, username string, tenderId uuid.UUID, lim
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return nil, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Check if tender exists.
 	if _, err := b.tenderSrv.Tender(ctx, tenderId); err != nil {
@@ -331,8 +636,8 @@ func (b *Bid) List(ctx context.Context, username string, tenderId uuid.UUID, lim
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Get tender's bids.
-	res, err := b.bidStorage.TenderBids(ctx, tenderId, limit, offset)
+	// Get published bids, they are visible to everyone.
+	res, err := b.bidStorage.PublishedBidsByPrice(ctx, tenderId)
 	if err != nil {
 		log.Error("failed to get tender's bids", sl.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -353,12 +658,12 @@ func (b *Bid) List(ctx context.Context, username string, tenderId uuid.UUID, lim
 }
 
 // My returns user's bids.
-func (b *Bid) My(ctx context.Context, username string, limit, offset int32) ([]models.BidOut, error) {
+// Returns cursor of the next page if there is one.
+func (b *Bid) My(ctx context.Context, limit, offset int32, after *models.Cursor) ([]models.BidOut, *models.Cursor, error) {
 	const op = "Bid.My"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.Int("limit", int(limit)),
 		slog.Int("offset", int(offset)),
 	)
@@ -366,7 +671,7 @@ func (b *Bid) My(ctx context.Context, username string, limit, offset int32) ([]m
 	ctx, err := b.bidStorage.Begin(ctx)
 	if err != nil {
 		log.Error("failed to start tx", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer func() {
 		if err := b.bidStorage.Rollback(ctx); err != nil {
@@ -374,21 +679,23 @@ func (b *Bid) My(ctx context.Context, username string, limit, offset int32) ([]m
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
-			return nil, err
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, nil, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get user's bids.
-	res, err := b.bidStorage.UserBids(ctx, username, limit, offset)
+	res, next, err := b.bidStorage.UserBids(ctx, username, limit, offset, after)
 	if err != nil {
 		log.Error("failed to get tenders", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Convert slice elements.
@@ -399,19 +706,18 @@ func (b *Bid) My(ctx context.Context, username string, limit, offset int32) ([]m
 
 	if err := b.bidStorage.Commit(ctx); err != nil {
 		log.Error("failed to commit", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return out, nil
+	return out, next, nil
 }
 
 // BidStatus return bid status.
-func (b *Bid) Status(ctx context.Context, username string, bidId uuid.UUID) (models.BidStatus, error) {
+func (b *Bid) Status(ctx context.Context, bidId uuid.UUID) (models.BidStatus, error) {
 	const op = "Bid.BidStatus"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("id", bidId.String()),
 	)
 
@@ -426,15 +732,17 @@ func (b *Bid) Status(ctx context.Context, username string, bidId uuid.UUID) (mod
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return "", err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get bid.
 	bid, err := b.bidStorage.Bid(ctx, bidId)
@@ -447,27 +755,14 @@ func (b *Bid) Status(ctx context.Context, username string, bidId uuid.UUID) (mod
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Check if user/org is allowed to modify bid.
-	switch bid.AuthorType {
-	case models.User:
-		userId, err := b.userSrv.UserId(ctx, username)
-		if err != nil {
-			log.Error("failed to get user's id", sl.Err(err))
-			return "", fmt.Errorf("%s: %w", op, err)
-		}
-		if userId != bid.AuthorId {
-			log.Warn("user not allowed to modify this bid")
-			return "", service.ErrNotEnoughPrivileges
-		}
-	case models.Organization:
-		if err := b.userSrv.Permission(ctx, username, bid.AuthorId); err != nil {
-			if errors.Is(err, service.ErrNotEnoughPrivileges) {
-				log.Warn("unallowed to modify")
-				return "", service.ErrNotEnoughPrivileges
-			}
-			log.Error("failed to check user permission")
-			return "", fmt.Errorf("%s: %w", op, err)
+	// Check if user is allowed to see bid.
+	if err := b.visible(ctx, username, bid); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("bid is hidden from user")
+			return "", err
 		}
+		log.Error("failed to check bid visibility", sl.Err(err))
+		return "", fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := b.bidStorage.Commit(ctx); err != nil {
@@ -478,13 +773,12 @@ func (b *Bid) Status(ctx context.Context, username string, bidId uuid.UUID) (mod
 	return bid.Status, nil
 }
 
-// BidSetStatus updates bid status.
-func (b *Bid) SetStatus(ctx context.Context, username string, bidId uuid.UUID, status models.BidStatus) (models.BidOut, error) {
-	const op = "Bid.BidSetStatus"
+// Get returns bid if it is visible to user.
+func (b *Bid) Get(ctx context.Context, bidId uuid.UUID) (models.BidOut, error) {
+	const op = "Bid.Get"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("id", bidId.String()),
 	)
 
@@ -499,51 +793,141 @@ func (b *Bid) SetStatus(ctx context.Context, username string, bidId uuid.UUID, s
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return models.BidOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get bid.
 	bid, err := b.bidStorage.Bid(ctx, bidId)
 	if err != nil {
 		if errors.Is(err, storage.ErrBidNotFound) {
-			log.Warn("tender not found")
+			log.Warn("bid not found")
 			return models.BidOut{}, service.ErrBidNotFound
 		}
-		log.Error("failed to get tender", sl.Err(err))
+		log.Error("failed to get bid", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Check if user/org is allowed to modify bid.
-	switch bid.AuthorType {
-	case models.User:
-		userId, err := b.userSrv.UserId(ctx, username)
-		if err != nil {
-			log.Error("failed to get user's id", sl.Err(err))
-			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
-		}
-		if userId != bid.AuthorId {
-			log.Warn("user not allowed to modify this bid")
-			return models.BidOut{}, service.ErrNotEnoughPrivileges
-		}
-	case models.Organization:
-		if err := b.userSrv.Permission(ctx, username, bid.AuthorId); err != nil {
-			if errors.Is(err, service.ErrNotEnoughPrivileges) {
-				log.Warn("unallowed to modify")
-				return models.BidOut{}, service.ErrNotEnoughPrivileges
-			}
-			log.Error("failed to check user permission")
-			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	// Check if user is allowed to see bid.
+	if err := b.visible(ctx, username, bid); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("bid is hidden from user")
+			return models.BidOut{}, err
+		}
+		log.Error("failed to check bid visibility", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := b.bidStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bid.ToOut(), nil
+}
+
+// BidSetStatus updates bid status.
+func (b *Bid) SetStatus(ctx context.Context, bidId uuid.UUID, status models.BidStatus) (models.BidOut, error) {
+	const op = "Bid.BidSetStatus"
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("id", bidId.String()),
+	)
+
+	ctx, err := b.bidStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := b.bidStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.BidOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get bid.
+	bid, err := b.bidStorage.Bid(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, storage.ErrBidNotFound) {
+			log.Warn("tender not found")
+			return models.BidOut{}, service.ErrBidNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user/org is allowed to modify bid.
+	switch bid.AuthorType {
+	case models.User:
+		userId, err := b.userSrv.UserId(ctx, username)
+		if err != nil {
+			log.Error("failed to get user's id", sl.Err(err))
+			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if userId != bid.AuthorId {
+			log.Warn("user not allowed to modify this bid")
+			return models.BidOut{}, service.ErrNotEnoughPrivileges
+		}
+	case models.Organization:
+		if err := b.userSrv.Permission(ctx, username, bid.AuthorId, models.ActionPublish); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				log.Warn("unallowed to modify")
+				return models.BidOut{}, err
+			}
+			log.Error("failed to check user permission")
+			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Check if status can be changed.
+	if !b.transitions.Allowed(bid.Status, status) {
+		log.Warn("invalid status transition", slog.String("current status", string(bid.Status)))
+		return models.BidOut{}, service.ErrInvalidTransition
+	}
+
+	// Check if tender still accepts bids.
+	if status == models.BidPublished {
+		tender, err := b.tenderSrv.Tender(ctx, bid.TenderId)
+		if err != nil {
+			if errors.Is(err, service.ErrTenderNotFound) {
+				log.Warn("tender not found")
+				return models.BidOut{}, service.ErrTenderNotFound
+			}
+			log.Error("failed to get tender", sl.Err(err))
+			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if tender.Status != models.TenderPublished {
+			log.Warn("tender is not published", slog.String("tender status", string(tender.Status)))
+			return models.BidOut{}, service.ErrTenderNotPublished
+		}
+		if tender.Overdue(time.Now()) {
+			log.Warn("tender deadline has passed")
+			return models.BidOut{}, service.ErrDeadlinePassed
 		}
 	}
 
-	// Update tender status.
+	// Update bid status.
 	bid, err = b.bidStorage.BidSetStatus(ctx, bidId, status)
 	if err != nil {
 		log.Error("failed to update bid status", sl.Err(err))
@@ -558,14 +942,232 @@ func (b *Bid) SetStatus(ctx context.Context, username string, bidId uuid.UUID, s
 	return bid.ToOut(), nil
 }
 
+// Withdraw withdraws published bid with reason.
+func (b *Bid) Withdraw(ctx context.Context, bidId uuid.UUID, withdrawalNew models.WithdrawalNew) (models.BidWithdrawalOut, error) {
+	const op = "Bid.Withdraw"
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("id", bidId.String()),
+	)
+
+	ctx, err := b.bidStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := b.bidStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.BidWithdrawalOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get bid.
+	bid, err := b.bidStorage.Bid(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, storage.ErrBidNotFound) {
+			log.Warn("bid not found")
+			return models.BidWithdrawalOut{}, service.ErrBidNotFound
+		}
+		log.Error("failed to get bid", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get user id.
+	userId, err := b.userSrv.UserId(ctx, username)
+	if err != nil {
+		log.Error("failed to get user's id", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user/org is allowed to modify bid.
+	if err := b.author(ctx, username, userId, bid); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("unallowed to modify")
+			return models.BidWithdrawalOut{}, err
+		}
+		log.Error("failed to check user permission", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if bid can be withdrawn.
+	if !b.withdrawTransitions.Allowed(bid.Status, models.BidWithdrawn) {
+		log.Warn("invalid status transition", slog.String("current status", string(bid.Status)))
+		return models.BidWithdrawalOut{}, service.ErrInvalidTransition
+	}
+
+	// Update bid status, withdrawn bid gets new version.
+	bid, err = b.nextVersion(ctx, bid, models.BidWithdrawn)
+	if err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			log.Warn("bid was modified concurrently")
+			return models.BidWithdrawalOut{}, err
+		}
+		log.Error("failed to update bid status", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Save withdrawal.
+	withdrawal, err := b.bidStorage.InsertWithdrawal(ctx, withdrawalNew.ToWithdrawal(userId, bidId))
+	if err != nil {
+		log.Error("failed to insert withdrawal", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := b.bidStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	withdrawalOut := withdrawal.ToOut()
+	return models.BidWithdrawalOut{BidOut: bid.ToOut(), Withdrawal: &withdrawalOut}, nil
+}
+
+// Resubmit publishes withdrawn or canceled bid again.
+// Bid can be resubmitted only while its tender accepts bids.
+// Decisions on previous version count only if decisions are kept between versions.
+func (b *Bid) Resubmit(ctx context.Context, bidId uuid.UUID) (models.BidWithdrawalOut, error) {
+	const op = "Bid.Resubmit"
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("id", bidId.String()),
+	)
+
+	ctx, err := b.bidStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := b.bidStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.BidWithdrawalOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get bid.
+	bid, err := b.bidStorage.Bid(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, storage.ErrBidNotFound) {
+			log.Warn("bid not found")
+			return models.BidWithdrawalOut{}, service.ErrBidNotFound
+		}
+		log.Error("failed to get bid", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get user id.
+	userId, err := b.userSrv.UserId(ctx, username)
+	if err != nil {
+		log.Error("failed to get user's id", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user/org is allowed to modify bid.
+	if err := b.author(ctx, username, userId, bid); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("unallowed to modify")
+			return models.BidWithdrawalOut{}, err
+		}
+		log.Error("failed to check user permission", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if bid can be resubmitted.
+	if !b.resubmitTransitions.Allowed(bid.Status, models.BidPublished) {
+		log.Warn("invalid status transition", slog.String("current status", string(bid.Status)))
+		return models.BidWithdrawalOut{}, service.ErrInvalidTransition
+	}
+
+	// Get bid's tender.
+	tender, err := b.tenderSrv.Tender(ctx, bid.TenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.BidWithdrawalOut{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if tender still accepts bids.
+	if tender.Status != models.TenderPublished {
+		log.Warn("tender is not published", slog.String("tender status", string(tender.Status)))
+		return models.BidWithdrawalOut{}, service.ErrTenderNotPublished
+	}
+	if tender.Overdue(time.Now()) {
+		log.Warn("tender deadline has passed")
+		return models.BidWithdrawalOut{}, service.ErrDeadlinePassed
+	}
+
+	// Update bid status, resubmitted bid gets new version.
+	bid, err = b.nextVersion(ctx, bid, models.BidPublished)
+	if err != nil {
+		if errors.Is(err, service.ErrVersionConflict) {
+			log.Warn("bid was modified concurrently")
+			return models.BidWithdrawalOut{}, err
+		}
+		log.Error("failed to update bid status", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res := models.BidWithdrawalOut{BidOut: bid.ToOut()}
+
+	// Close pending withdrawal, canceled bid has none.
+	withdrawal, err := b.bidStorage.ResubmitWithdrawal(ctx, bidId)
+	switch {
+	case err == nil:
+		withdrawalOut := withdrawal.ToOut()
+		res.Withdrawal = &withdrawalOut
+	case errors.Is(err, storage.ErrWithdrawalNotFound):
+		log.Debug("bid has no pending withdrawal")
+	default:
+		log.Error("failed to update withdrawal", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := b.bidStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.BidWithdrawalOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res, nil
+}
+
 // Edit edits bid.
-func (b *Bid) Edit(ctx context.Context, username string, bidId uuid.UUID, patch models.BidPatch) (models.BidOut, error) {
+// If version is not zero, bid is edited only if its current version equals to it,
+// otherwise current bid is returned with ErrVersionConflict.
+func (b *Bid) Edit(ctx context.Context, bidId uuid.UUID, version int32, patch models.BidPatch) (models.BidOut, error) {
 	const op = "Bid.Edit"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("id", bidId.String()),
+		slog.Int("version", int(version)),
 	)
 
 	ctx, err := b.bidStorage.Begin(ctx)
@@ -579,15 +1181,17 @@ func (b *Bid) Edit(ctx context.Context, username string, bidId uuid.UUID, patch
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return models.BidOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get tender.
 	bid, err := b.bidStorage.Bid(ctx, bidId)
@@ -613,27 +1217,75 @@ func (b *Bid) Edit(ctx context.Context, username string, bidId uuid.UUID, patch
 			return models.BidOut{}, service.ErrNotEnoughPrivileges
 		}
 	case models.Organization:
-		if err := b.userSrv.Permission(ctx, username, bid.AuthorId); err != nil {
+		if err := b.userSrv.Permission(ctx, username, bid.AuthorId, models.ActionEdit); err != nil {
 			if errors.Is(err, service.ErrNotEnoughPrivileges) {
 				log.Warn("unallowed to modify")
-				return models.BidOut{}, service.ErrNotEnoughPrivileges
+				return models.BidOut{}, err
 			}
 			log.Error("failed to check user permission")
 			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
+	// Check if bid's content can be changed.
+	if bid.Status.Terminal() {
+		log.Warn("bid is in terminal status", slog.String("current status", string(bid.Status)))
+		return models.BidOut{}, service.ErrInvalidTransition
+	}
+
+	// Check if bid was not modified since expected version.
+	if version != 0 && bid.Version != version {
+		log.Warn("version conflict", slog.Int("current version", int(bid.Version)))
+		return bid.ToOut(), service.ErrVersionConflict
+	}
+
+	// Get bid's tender.
+	tender, err := b.tenderSrv.Tender(ctx, bid.TenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.BidOut{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if tender still accepts bid changes.
+	if tender.Status != models.TenderPublished {
+		log.Warn("tender is not published", slog.String("tender status", string(tender.Status)))
+		return models.BidOut{}, service.ErrTenderNotPublished
+	}
+	if tender.Overdue(time.Now()) {
+		log.Warn("tender deadline has passed")
+		return models.BidOut{}, service.ErrDeadlinePassed
+	}
+
 	// Apply patch.
 	newBid := bid
 	newBid.Patch(patch)
 	newBid.Version += 1
 
+	// Check if new price fits tender's budget.
+	if err := b.budget(tender, newBid.BidTerms); err != nil {
+		log.Warn("bid price doesn't fit tender budget", sl.Err(err))
+		return models.BidOut{}, err
+	}
+
 	// Update bid.
-	if err := b.bidStorage.UpdateBid(ctx, newBid); err != nil {
+	if err := b.bidStorage.UpdateBid(ctx, newBid, bid.Version); err != nil {
 		if errors.Is(err, storage.ErrBidNotFound) {
 			log.Warn("bid not found")
 			return models.BidOut{}, service.ErrBidNotFound
 		}
+		if errors.Is(err, storage.ErrVersionConflict) {
+			log.Warn("bid was modified concurrently")
+			current, err := b.bidStorage.Bid(ctx, bidId)
+			if err != nil {
+				log.Error("failed to get bid", sl.Err(err))
+				return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+			}
+			return current.ToOut(), service.ErrVersionConflict
+		}
 		log.Error("failed to updated bid", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -644,6 +1296,12 @@ func (b *Bid) Edit(ctx context.Context, username string, bidId uuid.UUID, patch
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Keep decisions for new version if policy allows.
+	if err := b.carryOverDecisions(ctx, bidId, bid.Version, newBid.Version); err != nil {
+		log.Error("failed to carry over decisions", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
 	if err := b.bidStorage.Commit(ctx); err != nil {
 		log.Error("failed to commit", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
@@ -653,12 +1311,11 @@ func (b *Bid) Edit(ctx context.Context, username string, bidId uuid.UUID, patch
 }
 
 // Rollback rollbacks old version of bid.
-func (b *Bid) Rollback(ctx context.Context, username string, bidId uuid.UUID, version int32) (models.BidOut, error) {
+func (b *Bid) Rollback(ctx context.Context, bidId uuid.UUID, version int32) (models.BidOut, error) {
 	const op = "Tender.Rollback"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("user", username),
 		slog.String("id", bidId.String()),
 		slog.Int("version", int(version)),
 	)
@@ -674,15 +1331,17 @@ func (b *Bid) Rollback(ctx context.Context, username string, bidId uuid.UUID, ve
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return models.BidOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get actual tender.
 	bid, err := b.bidStorage.Bid(ctx, bidId)
@@ -708,16 +1367,43 @@ func (b *Bid) Rollback(ctx context.Context, username string, bidId uuid.UUID, ve
 			return models.BidOut{}, service.ErrNotEnoughPrivileges
 		}
 	case models.Organization:
-		if err := b.userSrv.Permission(ctx, username, bid.AuthorId); err != nil {
+		if err := b.userSrv.Permission(ctx, username, bid.AuthorId, models.ActionEdit); err != nil {
 			if errors.Is(err, service.ErrNotEnoughPrivileges) {
 				log.Warn("unallowed to modify")
-				return models.BidOut{}, service.ErrNotEnoughPrivileges
+				return models.BidOut{}, err
 			}
 			log.Error("failed to check user permission")
 			return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
+	// Check if bid's content can be changed.
+	if bid.Status.Terminal() {
+		log.Warn("bid is in terminal status", slog.String("current status", string(bid.Status)))
+		return models.BidOut{}, service.ErrInvalidTransition
+	}
+
+	// Get bid's tender.
+	tender, err := b.tenderSrv.Tender(ctx, bid.TenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.BidOut{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if tender still accepts bid changes.
+	if tender.Status != models.TenderPublished {
+		log.Warn("tender is not published", slog.String("tender status", string(tender.Status)))
+		return models.BidOut{}, service.ErrTenderNotPublished
+	}
+	if tender.Overdue(time.Now()) {
+		log.Warn("tender deadline has passed")
+		return models.BidOut{}, service.ErrDeadlinePassed
+	}
+
 	// Save outdated tender and recover old tender.
 	recoveredBid, err := b.rollbackSrv.SwapBid(ctx, bidId, version, bid)
 	if err != nil {
@@ -729,12 +1415,27 @@ func (b *Bid) Rollback(ctx context.Context, username string, bidId uuid.UUID, ve
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Save recovered tender.
+	// Check if recovered price fits tender's budget.
+	if err := b.budget(tender, recoveredBid.BidTerms); err != nil {
+		log.Warn("bid price doesn't fit tender budget", sl.Err(err))
+		return models.BidOut{}, err
+	}
+
+	// Save recovered bid in place of actual one.
 	recoveredBid.Version = bid.Version + 1
 	recoveredBid.Status = bid.Status
-	newTender, err := b.bidStorage.InsertBid(ctx, recoveredBid)
-	if err != nil {
-		log.Error("failed to insert tender", sl.Err(err))
+	if err := b.bidStorage.UpdateBid(ctx, recoveredBid, bid.Version); err != nil {
+		if errors.Is(err, storage.ErrVersionConflict) {
+			log.Warn("bid was modified concurrently")
+			return models.BidOut{}, service.ErrVersionConflict
+		}
+		log.Error("failed to update bid", sl.Err(err))
+		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Keep decisions for new version if policy allows.
+	if err := b.carryOverDecisions(ctx, bidId, bid.Version, recoveredBid.Version); err != nil {
+		log.Error("failed to carry over decisions", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -743,18 +1444,18 @@ func (b *Bid) Rollback(ctx context.Context, username string, bidId uuid.UUID, ve
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return newTender.ToOut(), nil
+	return recoveredBid.ToOut(), nil
 }
 
-// Reviews returns
-func (b *Bid) Reviews(ctx context.Context, requester, author string, tenderId uuid.UUID, limit, offset int32) ([]models.ReviewOut, error) {
-	const op = "Bid.Reviews"
+// Versions returns outdated versions of bid available for rollback.
+func (b *Bid) Versions(ctx context.Context, bidId uuid.UUID, limit, offset int32) ([]models.BidOut, error) {
+	const op = "Bid.Versions"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("requester", requester),
-		slog.String("author", author),
-		slog.String("tender id", tenderId.String()),
+		slog.String("id", bidId.String()),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
 	)
 
 	ctx, err := b.bidStorage.Begin(ctx)
@@ -768,23 +1469,342 @@ func (b *Bid) Reviews(ctx context.Context, requester, author string, tenderId uu
 		}
 	}()
 
-	// Check if requester exists
-	if err := b.userSrv.Validate(ctx, requester); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return nil, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get bid.
+	bid, err := b.bidStorage.Bid(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, storage.ErrBidNotFound) {
+			log.Warn("bid not found")
+			return nil, service.ErrBidNotFound
+		}
+		log.Error("failed to get bid", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user/org is allowed to see bid history.
+	switch bid.AuthorType {
+	case models.User:
+		userId, err := b.userSrv.UserId(ctx, username)
+		if err != nil {
+			log.Error("failed to get user's id", sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if userId != bid.AuthorId {
+			log.Warn("user not allowed to see versions")
+			return nil, service.ErrNotEnoughPrivileges
+		}
+	case models.Organization:
+		if err := b.userSrv.Permission(ctx, username, bid.AuthorId, models.ActionView); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				log.Warn("unallowed to see versions")
+				return nil, err
+			}
+			log.Error("failed to check user permission", sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Get outdated versions.
+	res, err := b.rollbackSrv.BidVersions(ctx, bidId, limit, offset)
+	if err != nil {
+		log.Error("failed to get versions", sl.Err(err))
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+
+	// Convert slice elements.
+	out := make([]models.BidOut, 0, len(res))
+	for i := range res {
+		out = append(out, res[i].ToOut())
+	}
+
+	if err := b.bidStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, nil
+}
+
+// Diff returns changes between two versions of bid.
+func (b *Bid) Diff(ctx context.Context, bidId uuid.UUID, fromVersion, toVersion int32) (models.Diff, error) {
+	const op = "Bid.Diff"
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("id", bidId.String()),
+		slog.Int("from", int(fromVersion)),
+		slog.Int("to", int(toVersion)),
+	)
+
+	ctx, err := b.bidStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := b.bidStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.Diff{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get bid.
+	bid, err := b.bidStorage.Bid(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, storage.ErrBidNotFound) {
+			log.Warn("bid not found")
+			return models.Diff{}, service.ErrBidNotFound
+		}
+		log.Error("failed to get bid", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user/org is allowed to see bid history.
+	switch bid.AuthorType {
+	case models.User:
+		userId, err := b.userSrv.UserId(ctx, username)
+		if err != nil {
+			log.Error("failed to get user's id", sl.Err(err))
+			return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if userId != bid.AuthorId {
+			log.Warn("user not allowed to see versions")
+			return models.Diff{}, service.ErrNotEnoughPrivileges
+		}
+	case models.Organization:
+		if err := b.userSrv.Permission(ctx, username, bid.AuthorId, models.ActionView); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				log.Warn("unallowed to see versions")
+				return models.Diff{}, err
+			}
+			log.Error("failed to check user permission", sl.Err(err))
+			return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Get compared versions.
+	from, err := b.version(ctx, bid, fromVersion)
+	if err != nil {
+		if errors.Is(err, service.ErrVersionNotFound) {
+			log.Warn("from version not found")
+			return models.Diff{}, service.ErrVersionNotFound
+		}
+		log.Error("failed to get from version", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+	to, err := b.version(ctx, bid, toVersion)
+	if err != nil {
+		if errors.Is(err, service.ErrVersionNotFound) {
+			log.Warn("to version not found")
+			return models.Diff{}, service.ErrVersionNotFound
+		}
+		log.Error("failed to get to version", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := b.bidStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return models.BidDiff(from, to), nil
+}
+
+// visible checks if bid can be seen by user.
+// Public bids are visible to everyone, other ones only to
+// bid's author and responsibles of tender's organization.
+func (b *Bid) visible(ctx context.Context, username string, bid models.Bid) error {
+	const op = "Bid.visible"
+
+	if bid.Public() {
+		return nil
+	}
+
+	// Check if user is bid's author.
+	switch bid.AuthorType {
+	case models.User:
+		userId, err := b.userSrv.UserId(ctx, username)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if userId == bid.AuthorId {
+			return nil
+		}
+	case models.Organization:
+		err := b.userSrv.Permission(ctx, username, bid.AuthorId, models.ActionView)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Check if user is responsible for tender.
+	tender, err := b.tenderSrv.Tender(ctx, bid.TenderId)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := b.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			return service.ErrNotEnoughPrivileges
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// budget checks bid's price against tender's budget in strict mode.
+// Bid without price and tender without budget are always accepted.
+func (b *Bid) budget(tender models.Tender, terms models.BidTerms) error {
+	if !b.strictBudget || tender.Budget == nil || terms.Price == nil {
+		return nil
+	}
+
+	if *terms.Currency != *tender.Currency {
+		return service.ErrCurrencyMismatch
+	}
+	if *terms.Price > *tender.Budget {
+		return service.ErrOverBudget
+	}
+
+	return nil
+}
+
+// author checks if user is allowed to change status of bid:
+// user must be its author or be able to publish organization's bids.
+func (b *Bid) author(ctx context.Context, username string, userId uuid.UUID, bid models.Bid) error {
+	const op = "Bid.author"
+
+	switch bid.AuthorType {
+	case models.User:
+		if bid.AuthorId != userId {
+			return service.ErrNotEnoughPrivileges
+		}
+	case models.Organization:
+		if err := b.userSrv.Permission(ctx, username, bid.AuthorId, models.ActionPublish); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				return err
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
+
+// carryOverDecisions copies decisions on old version of bid to new one
+// if decisions are kept between versions.
+// Otherwise they stay in log, but don't count for new version.
+func (b *Bid) carryOverDecisions(ctx context.Context, bidId uuid.UUID, from, to int32) error {
+	if !b.keepDecisions {
+		return nil
+	}
+	return b.bidStorage.CarryOverDecisions(ctx, bidId, from, to)
+}
+
+// nextVersion sets status of bid as its new version.
+// Current version is saved for rollback, decisions are
+// carried over according to policy.
+func (b *Bid) nextVersion(ctx context.Context, bid models.Bid, status models.BidStatus) (models.Bid, error) {
+	const op = "Bid.nextVersion"
+
+	newBid := bid
+	newBid.Status = status
+	newBid.Version += 1
+
+	if err := b.bidStorage.UpdateBid(ctx, newBid, bid.Version); err != nil {
+		if errors.Is(err, storage.ErrVersionConflict) {
+			return models.Bid{}, service.ErrVersionConflict
+		}
+		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := b.rollbackSrv.SaveBid(ctx, bid); err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := b.carryOverDecisions(ctx, bid.Id, bid.Version, newBid.Version); err != nil {
+		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return newBid, nil
+}
+
+// version returns bid of given version.
+// Actual bid is returned as is, outdated one is taken from rollback.
+func (b *Bid) version(ctx context.Context, bid models.Bid, version int32) (models.Bid, error) {
+	if version == bid.Version {
+		return bid, nil
+	}
+	return b.rollbackSrv.BidVersion(ctx, bid.Id, version)
+}
+
+// Reviews returns author's reviews on bids of tender.
+// Returns cursor of the next page if there is one.
+func (b *Bid) Reviews(ctx context.Context, author string, tenderId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.ReviewOut, *models.Cursor, error) {
+	const op = "Bid.Reviews"
+
+	log := b.log.With(
+		slog.String("op", op),
+		slog.String("author", author),
+		slog.String("tender id", tenderId.String()),
+	)
+
+	ctx, err := b.bidStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := b.bidStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get requester.
+	requester, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("requester", requester))
+
 	// Check if author exists
 	if err := b.userSrv.Validate(ctx, author); err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			log.Warn("user not found")
-			return nil, service.ErrAuthorNotFound
+			return nil, nil, service.ErrAuthorNotFound
 		}
 		log.Error("failed to verify user", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Get bid's tender.
@@ -792,27 +1812,27 @@ func (b *Bid) Reviews(ctx context.Context, requester, author string, tenderId uu
 	if err != nil {
 		if errors.Is(err, service.ErrTenderNotFound) {
 			log.Warn("tender not found")
-			return nil, service.ErrTenderNotFound
+			return nil, nil, service.ErrTenderNotFound
 		}
 		log.Error("failed to get tender")
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Check if user is allowed to view tender's feedbacks.
-	if err := b.userSrv.Permission(ctx, requester, tender.OrgId); err != nil {
+	if err := b.userSrv.Permission(ctx, requester, tender.OrgId, models.ActionView); err != nil {
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
 			log.Warn("unallowed to modify")
-			return nil, service.ErrNotEnoughPrivileges
+			return nil, nil, err
 		}
 		log.Error("failed to check user permission")
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Get reviews.
-	res, err := b.bidStorage.Reviews(ctx, tenderId, author, limit, offset)
+	res, next, err := b.bidStorage.Reviews(ctx, tenderId, author, limit, offset, after)
 	if err != nil {
 		log.Error("failed to get reviews", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Convert slice's elements.
@@ -823,20 +1843,19 @@ func (b *Bid) Reviews(ctx context.Context, requester, author string, tenderId uu
 
 	if err := b.bidStorage.Commit(ctx); err != nil {
 		log.Error("failed to commit", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return out, nil
+	return out, next, nil
 }
 
 // Feedback creates feedback for a bid.
 // If user is not allowed returnes error.
-func (b *Bid) Feedback(ctx context.Context, username string, bidId uuid.UUID, feedback string) (models.BidOut, error) {
+func (b *Bid) Feedback(ctx context.Context, bidId uuid.UUID, feedback string) (models.BidOut, error) {
 	const op = "Bid.Feedback"
 
 	log := b.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("id", bidId.String()),
 	)
 
@@ -851,15 +1870,17 @@ func (b *Bid) Feedback(ctx context.Context, username string, bidId uuid.UUID, fe
 		}
 	}()
 
-	// Check if user exists
-	if err := b.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := b.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return models.BidOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get bid.
 	bid, err := b.bidStorage.Bid(ctx, bidId)
@@ -884,10 +1905,10 @@ func (b *Bid) Feedback(ctx context.Context, username string, bidId uuid.UUID, fe
 	}
 
 	// Check if user is allowed to modify tender.
-	if err := b.userSrv.Permission(ctx, username, tender.OrgId); err != nil {
+	if err := b.userSrv.Permission(ctx, username, tender.OrgId, models.ActionReview); err != nil {
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
 			log.Warn("unallowed to modify")
-			return models.BidOut{}, service.ErrNotEnoughPrivileges
+			return models.BidOut{}, err
 		}
 		log.Error("failed to check user permission")
 		return models.BidOut{}, fmt.Errorf("%s: %w", op, err)
@@ -912,3 +1933,12 @@ func (b *Bid) Feedback(ctx context.Context, username string, bidId uuid.UUID, fe
 
 	return bid.ToOut(), nil
 }
+
+// decisionStatus returns bid status
+// corresponding to decision.
+func decisionStatus(decision models.DecisionType) models.BidStatus {
+	if decision == models.Approved {
+		return models.BidApproved
+	}
+	return models.BidRejected
+}
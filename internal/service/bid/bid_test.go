@@ -22,6 +22,7 @@ var (
 	BID_UUID    = uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd")
 	BID_UUID2   = uuid.MustParse("9cee2253-3d20-4f88-8bb4-5118cc7932f8")
 	ORG_UUID    = uuid.MustParse("002f9d2b-cd76-4921-8e53-21dbde75f993")
+	ORG_UUID2   = uuid.MustParse("6a1f3e0b-8c2d-4b7e-9f45-3d2c1b0a9e87")
 	AUTH_UUID   = uuid.MustParse("ce61bdc8-d435-454a-92c7-5e51c9a21907")
 	TENDER_UUID = uuid.MustParse("0284744f-ee56-485d-b124-173315723ba6")
 	REVIEW_UUID = uuid.MustParse("75129d25-acbe-4e64-9e57-342781135841")
@@ -29,19 +30,34 @@ var (
 
 func TestNewBid(t *testing.T) {
 	type args struct {
-		ctx    context.Context
-		bidNew models.BidNew
+		ctx      context.Context
+		username string
+		bidNew   models.BidNew
 	}
 	type want struct {
 		bid models.BidOut
 		err error
 	}
+	type validateRes struct {
+		err error
+	}
 	type validateUserRes struct {
 		err error
 	}
 	type validateOrgRes struct {
 		err error
 	}
+	type userIdRes struct {
+		id  uuid.UUID
+		err error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
 	type insertBidRes struct {
 		bid models.Bid
 		err error
@@ -50,19 +66,27 @@ func TestNewBid(t *testing.T) {
 		name            string
 		args            args
 		want            want
+		validateRes     *validateRes
 		validateUserRes *validateUserRes
 		validateOrgRes  *validateOrgRes
+		userIdRes       *userIdRes
+		permissionRes   *permissionRes
+		tenderRes       *tenderRes
 		insertBidRes    *insertBidRes
 	}{
 		{
 			name: "main line org",
-			args: args{context.Background(), models.BidNew{
+			args: args{context.Background(), "user", models.BidNew{
 				BidBase: models.BidBase{
 					AuthorType: models.Organization,
 					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
 				},
 			}},
+			validateRes:    &validateRes{nil},
 			validateOrgRes: &validateOrgRes{nil},
+			permissionRes:  &permissionRes{nil},
+			tenderRes:      &tenderRes{models.Tender{Status: models.TenderPublished}, nil},
 			insertBidRes: &insertBidRes{models.Bid{
 				Id:        BID_UUID,
 				Version:   1,
@@ -70,6 +94,7 @@ func TestNewBid(t *testing.T) {
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.Organization,
+					TenderId:   TENDER_UUID,
 				},
 			}, nil},
 			want: want{models.BidOut{
@@ -79,18 +104,23 @@ func TestNewBid(t *testing.T) {
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.Organization,
+					TenderId:   TENDER_UUID,
 				},
 			}, nil},
 		},
 		{
 			name: "main line user",
-			args: args{context.Background(), models.BidNew{
+			args: args{context.Background(), "user", models.BidNew{
 				BidBase: models.BidBase{
 					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
 				},
 			}},
+			validateRes:     &validateRes{nil},
 			validateUserRes: &validateUserRes{nil},
+			userIdRes:       &userIdRes{AUTH_UUID, nil},
+			tenderRes:       &tenderRes{models.Tender{Status: models.TenderPublished}, nil},
 			insertBidRes: &insertBidRes{models.Bid{
 				Id:        BID_UUID,
 				Version:   1,
@@ -98,6 +128,7 @@ func TestNewBid(t *testing.T) {
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.User,
+					TenderId:   TENDER_UUID,
 				},
 			}, nil},
 			want: want{models.BidOut{
@@ -107,68 +138,179 @@ func TestNewBid(t *testing.T) {
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.User,
+					TenderId:   TENDER_UUID,
 				},
 			}, nil},
 		},
 		{
-			name: "main line org",
-			args: args{context.Background(), models.BidNew{
+			name: "caller not found",
+			args: args{context.Background(), "user", models.BidNew{
 				BidBase: models.BidBase{
-					AuthorType: models.Organization,
+					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
 				},
 			}},
-			validateOrgRes: &validateOrgRes{nil},
-			insertBidRes: &insertBidRes{models.Bid{
-				Id:        BID_UUID,
-				Version:   1,
-				CreatedAt: time.Unix(10000, 0),
+			validateRes: &validateRes{service.ErrUserNotFound},
+			want:        want{models.BidOut{}, service.ErrUserNotFound},
+		},
+		{
+			name: "author invalid",
+			args: args{context.Background(), "user", models.BidNew{
 				BidBase: models.BidBase{
+					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
+				},
+			}},
+			validateRes:     &validateRes{nil},
+			validateUserRes: &validateUserRes{service.ErrUserNotFound},
+			want:            want{models.BidOut{}, service.ErrAuthorNotFound},
+		},
+		{
+			name: "org invalid",
+			args: args{context.Background(), "user", models.BidNew{
+				BidBase: models.BidBase{
 					AuthorType: models.Organization,
+					AuthorId:   AUTH_UUID,
 				},
-			}, nil},
-			want: want{models.BidOut{
-				Id:        BID_UUID,
-				Version:   1,
-				CreatedAt: time.Unix(10000, 0),
+			}},
+			validateRes:    &validateRes{nil},
+			validateOrgRes: &validateOrgRes{service.ErrOrganizationNotFound},
+			want:           want{models.BidOut{}, service.ErrOrganizationNotFound},
+		},
+		{
+			name: "bid on behalf of other user",
+			args: args{context.Background(), "user", models.BidNew{
 				BidBase: models.BidBase{
+					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
+				},
+			}},
+			validateRes:     &validateRes{nil},
+			validateUserRes: &validateUserRes{nil},
+			userIdRes:       &userIdRes{BID_UUID2, nil},
+			want:            want{models.BidOut{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name: "bid on behalf of foreign org",
+			args: args{context.Background(), "user", models.BidNew{
+				BidBase: models.BidBase{
 					AuthorType: models.Organization,
+					AuthorId:   AUTH_UUID,
+				},
+			}},
+			validateRes:    &validateRes{nil},
+			validateOrgRes: &validateOrgRes{nil},
+			permissionRes:  &permissionRes{&service.PermissionError{Action: models.ActionEdit}},
+			want:           want{models.BidOut{}, &service.PermissionError{Action: models.ActionEdit}},
+		},
+		{
+			name: "tender not found",
+			args: args{context.Background(), "user", models.BidNew{
+				BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
+				},
+			}},
+			validateRes:     &validateRes{nil},
+			validateUserRes: &validateUserRes{nil},
+			userIdRes:       &userIdRes{AUTH_UUID, nil},
+			tenderRes:       &tenderRes{models.Tender{}, service.ErrTenderNotFound},
+			want:            want{models.BidOut{}, service.ErrTenderNotFound},
+		},
+		{
+			name: "tender not published",
+			args: args{context.Background(), "user", models.BidNew{
+				BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
 				},
+			}},
+			validateRes:     &validateRes{nil},
+			validateUserRes: &validateUserRes{nil},
+			userIdRes:       &userIdRes{AUTH_UUID, nil},
+			tenderRes:       &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderClosed}, nil},
+			want:            want{models.BidOut{}, service.ErrTenderNotPublished},
+		},
+		{
+			name: "deadline passed",
+			args: args{context.Background(), "user", models.BidNew{
+				BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
+				},
+			}},
+			validateRes:     &validateRes{nil},
+			validateUserRes: &validateUserRes{nil},
+			userIdRes:       &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{
+				Id:         TENDER_UUID,
+				Status:     models.TenderPublished,
+				TenderBase: models.TenderBase{Deadline: ptr.Ptr(time.Now().Add(-time.Hour))},
 			}, nil},
+			want: want{models.BidOut{}, service.ErrDeadlinePassed},
 		},
 		{
-			name: "user invalid",
-			args: args{bidNew: models.BidNew{
+			name: "price over budget",
+			args: args{context.Background(), "user", models.BidNew{
 				BidBase: models.BidBase{
 					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
+					BidTerms:   models.BidTerms{Price: ptr.Ptr[int64](1500), Currency: ptr.Ptr("USD")},
+				},
+			}},
+			validateRes:     &validateRes{nil},
+			validateUserRes: &validateUserRes{nil},
+			userIdRes:       &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{
+				Id:     TENDER_UUID,
+				Status: models.TenderPublished,
+				TenderBase: models.TenderBase{TenderTerms: models.TenderTerms{
+					Budget: ptr.Ptr[int64](1000), Currency: ptr.Ptr("USD"),
 				}},
-			},
-			validateUserRes: &validateUserRes{service.ErrUserNotFound},
-			want:            want{models.BidOut{}, service.ErrUserNotFound},
+			}, nil},
+			want: want{models.BidOut{}, service.ErrOverBudget},
 		},
 		{
-			name: "org invalid",
-			args: args{bidNew: models.BidNew{
+			name: "currency mismatch",
+			args: args{context.Background(), "user", models.BidNew{
 				BidBase: models.BidBase{
-					AuthorType: models.Organization,
+					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
+					BidTerms:   models.BidTerms{Price: ptr.Ptr[int64](500), Currency: ptr.Ptr("EUR")},
+				},
+			}},
+			validateRes:     &validateRes{nil},
+			validateUserRes: &validateUserRes{nil},
+			userIdRes:       &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{
+				Id:     TENDER_UUID,
+				Status: models.TenderPublished,
+				TenderBase: models.TenderBase{TenderTerms: models.TenderTerms{
+					Budget: ptr.Ptr[int64](1000), Currency: ptr.Ptr("USD"),
 				}},
-			},
-			validateOrgRes: &validateOrgRes{service.ErrOrganizationNotFound},
-			want:           want{models.BidOut{}, service.ErrOrganizationNotFound},
+			}, nil},
+			want: want{models.BidOut{}, service.ErrCurrencyMismatch},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
 			bStorage := mocks.NewBidStorage(t)
 
 			bStorage.
 				On("Begin", tt.args.ctx).
 				Return(tt.args.ctx, nil)
+			if tt.validateRes != nil {
+				user.
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, tt.validateRes.err)
+			}
 			if tt.validateUserRes != nil {
 				user.
 					On("ValidateUserId", tt.args.ctx, tt.args.bidNew.AuthorId).
@@ -179,6 +321,21 @@ func TestNewBid(t *testing.T) {
 					On("ValidateOrgId", tt.args.ctx, tt.args.bidNew.AuthorId).
 					Return(tt.validateOrgRes.err)
 			}
+			if tt.userIdRes != nil {
+				user.
+					On("UserId", tt.args.ctx, tt.args.username).
+					Return(tt.userIdRes.id, tt.userIdRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, tt.args.bidNew.AuthorId, models.ActionEdit).
+					Return(tt.permissionRes.err)
+			}
+			if tt.tenderRes != nil {
+				tenderSrv.
+					On("Tender", mock.Anything, tt.args.bidNew.TenderId).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
 			if tt.insertBidRes != nil {
 				bStorage.
 					On("InsertBid", mock.Anything, mock.Anything).
@@ -197,8 +354,10 @@ func TestNewBid(t *testing.T) {
 			bid := Bid{
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
-				userSrv:    user,
-				bidStorage: bStorage,
+				userSrv:      user,
+				tenderSrv:    tenderSrv,
+				bidStorage:   bStorage,
+				strictBudget: true,
 			}
 
 			res, err := bid.New(tt.args.ctx, tt.args.bidNew)
@@ -248,7 +407,18 @@ func TestDecision(t *testing.T) {
 		size int64
 		err  error
 	}
+	type quorumRes struct {
+		quorum models.Quorum
+		err    error
+	}
 	type updBidRes struct {
+		bid models.Bid
+		err error
+	}
+	type closeTenderRes struct {
+		err error
+	}
+	type rejectBidsRes struct {
 		err error
 	}
 	type want struct {
@@ -263,12 +433,226 @@ func TestDecision(t *testing.T) {
 		tenderRes     *tenderRes
 		permissionRes *permissionRes
 		userIdRes     *userIdRes
-		insertDecRes  *insertDecRes
-		decisionsRes  *decisionsRes
-		orgSizeRes    *orgSizeRes
-		updBidRes     *updBidRes
-		want          want
-	}{}
+		// Membership in bid author's organization.
+		authorPermissionRes *permissionRes
+		insertDecRes        *insertDecRes
+		decisionsRes        *decisionsRes
+		orgSizeRes          *orgSizeRes
+		quorumRes           *quorumRes
+		updBidRes           *updBidRes
+		closeTenderRes      *closeTenderRes
+		rejectBidsRes       *rejectBidsRes
+		commit              bool
+		tally               models.Tally
+		want                want
+	}{
+		{
+			name:          "approved by quorum",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			userIdRes:     &userIdRes{AUTH_UUID, nil},
+			insertDecRes:  &insertDecRes{nil},
+			decisionsRes: &decisionsRes{[]models.Decision{
+				{UserId: AUTH_UUID, BidId: BID_UUID, Decision: models.Approved},
+			}, nil},
+			orgSizeRes:     &orgSizeRes{1, nil},
+			quorumRes:      &quorumRes{models.DefaultQuorum(), nil},
+			updBidRes:      &updBidRes{models.Bid{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			closeTenderRes: &closeTenderRes{nil},
+			rejectBidsRes:  &rejectBidsRes{nil},
+			commit:         true,
+			tally:          models.Tally{Approvals: 1, Required: 1},
+			want:           want{models.BidOut{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+		},
+		{
+			name:          "tender can't be closed",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			userIdRes:     &userIdRes{AUTH_UUID, nil},
+			insertDecRes:  &insertDecRes{nil},
+			decisionsRes: &decisionsRes{[]models.Decision{
+				{UserId: AUTH_UUID, BidId: BID_UUID, Decision: models.Approved},
+			}, nil},
+			orgSizeRes:     &orgSizeRes{1, nil},
+			quorumRes:      &quorumRes{models.DefaultQuorum(), nil},
+			updBidRes:      &updBidRes{models.Bid{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			closeTenderRes: &closeTenderRes{service.ErrInvalidTransition},
+			tally:          models.Tally{},
+			want:           want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name:          "rejected",
+			args:          args{context.Background(), "user", BID_UUID, models.Rejected},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			userIdRes:     &userIdRes{AUTH_UUID, nil},
+			insertDecRes:  &insertDecRes{nil},
+			decisionsRes: &decisionsRes{[]models.Decision{
+				{UserId: AUTH_UUID, BidId: BID_UUID, Decision: models.Rejected},
+			}, nil},
+			orgSizeRes: &orgSizeRes{3, nil},
+			quorumRes:  &quorumRes{models.DefaultQuorum(), nil},
+			updBidRes:  &updBidRes{models.Bid{Id: BID_UUID, Status: models.BidRejected, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			commit:     true,
+			tally:      models.Tally{Rejections: 1, Required: 3},
+			want:       want{models.BidOut{Id: BID_UUID, Status: models.BidRejected, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+		},
+		{
+			name:          "inconclusive",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			userIdRes:     &userIdRes{AUTH_UUID, nil},
+			insertDecRes:  &insertDecRes{nil},
+			decisionsRes: &decisionsRes{[]models.Decision{
+				{UserId: AUTH_UUID, BidId: BID_UUID, Decision: models.Approved},
+			}, nil},
+			orgSizeRes: &orgSizeRes{3, nil},
+			quorumRes:  &quorumRes{models.DefaultQuorum(), nil},
+			commit:     true,
+			tally:      models.Tally{Approvals: 1, Required: 3},
+			want:       want{models.BidOut{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+		},
+		{
+			name:          "approved by percent quorum without veto",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			userIdRes:     &userIdRes{AUTH_UUID, nil},
+			insertDecRes:  &insertDecRes{nil},
+			decisionsRes: &decisionsRes{[]models.Decision{
+				{UserId: uuid.New(), BidId: BID_UUID, Decision: models.Rejected},
+				{UserId: uuid.New(), BidId: BID_UUID, Decision: models.Approved},
+				{UserId: AUTH_UUID, BidId: BID_UUID, Decision: models.Approved},
+			}, nil},
+			orgSizeRes:     &orgSizeRes{4, nil},
+			quorumRes:      &quorumRes{models.Quorum{Type: models.QuorumPercent, Value: 50}, nil},
+			updBidRes:      &updBidRes{models.Bid{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			closeTenderRes: &closeTenderRes{nil},
+			rejectBidsRes:  &rejectBidsRes{nil},
+			commit:         true,
+			tally:          models.Tally{Approvals: 2, Rejections: 1, Required: 2},
+			want:           want{models.BidOut{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+		},
+		{
+			// Org has two approvers and a viewer, viewer can't vote.
+			name:          "approved unanimously with viewer in org",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			userIdRes:     &userIdRes{AUTH_UUID, nil},
+			insertDecRes:  &insertDecRes{nil},
+			decisionsRes: &decisionsRes{[]models.Decision{
+				{UserId: uuid.New(), BidId: BID_UUID, Decision: models.Approved},
+				{UserId: AUTH_UUID, BidId: BID_UUID, Decision: models.Approved},
+			}, nil},
+			orgSizeRes:     &orgSizeRes{2, nil},
+			quorumRes:      &quorumRes{models.Quorum{Type: models.QuorumUnanimous}, nil},
+			updBidRes:      &updBidRes{models.Bid{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			closeTenderRes: &closeTenderRes{nil},
+			rejectBidsRes:  &rejectBidsRes{nil},
+			commit:         true,
+			tally:          models.Tally{Approvals: 2, Required: 2},
+			want:           want{models.BidOut{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+		},
+		{
+			name:          "bid not published",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidCreated, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			want:          want{models.BidOut{}, service.ErrBidNotPublished},
+		},
+		{
+			name:          "tender closed",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderClosed, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			want:          want{models.BidOut{}, service.ErrTenderNotPublished},
+		},
+		{
+			name:        "own bid",
+			args:        args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{
+				TenderId:   TENDER_UUID,
+				AuthorType: models.User,
+				AuthorId:   AUTH_UUID,
+			}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			userIdRes:     &userIdRes{AUTH_UUID, nil},
+			want:          want{models.BidOut{}, service.ErrConflictOfInterest},
+		},
+		{
+			name:        "own organization's bid",
+			args:        args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{
+				TenderId:   TENDER_UUID,
+				AuthorType: models.Organization,
+				AuthorId:   ORG_UUID2,
+			}}, nil},
+			tenderRes:           &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes:       &permissionRes{nil},
+			userIdRes:           &userIdRes{AUTH_UUID, nil},
+			authorPermissionRes: &permissionRes{nil},
+			want:                want{models.BidOut{}, service.ErrConflictOfInterest},
+		},
+		{
+			name:        "other organization's bid",
+			args:        args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{
+				TenderId:   TENDER_UUID,
+				AuthorType: models.Organization,
+				AuthorId:   ORG_UUID2,
+			}}, nil},
+			tenderRes:           &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes:       &permissionRes{nil},
+			userIdRes:           &userIdRes{AUTH_UUID, nil},
+			authorPermissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+			insertDecRes:        &insertDecRes{nil},
+			decisionsRes: &decisionsRes{[]models.Decision{
+				{UserId: AUTH_UUID, BidId: BID_UUID, Decision: models.Approved},
+			}, nil},
+			orgSizeRes: &orgSizeRes{3, nil},
+			quorumRes:  &quorumRes{models.DefaultQuorum(), nil},
+			commit:     true,
+			tally:      models.Tally{Approvals: 1, Required: 3},
+			want: want{models.BidOut{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{
+				TenderId:   TENDER_UUID,
+				AuthorType: models.Organization,
+				AuthorId:   ORG_UUID2,
+			}}, nil},
+		},
+		{
+			name:          "no permissions",
+			args:          args{context.Background(), "user", BID_UUID, models.Approved},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
+			want:          want{models.BidOut{}, service.ErrNotEnoughPrivileges},
+		},
+	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			user := mocks.NewUserService(t)
@@ -277,11 +661,11 @@ func TestDecision(t *testing.T) {
 
 			bStorage.
 				On("Begin", tt.args.ctx).
-				Return(tt.args.ctx)
+				Return(tt.args.ctx, nil)
 			if tt.validateRes != nil {
 				user.
-					On("Validate", tt.args.ctx, tt.args.username).
-					Return(tt.validateRes.err)
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, tt.validateRes.err)
 			}
 			if tt.bidRes != nil {
 				bStorage.
@@ -295,7 +679,7 @@ func TestDecision(t *testing.T) {
 			}
 			if tt.permissionRes != nil {
 				user.
-					On("Permission", tt.args.ctx, tt.tenderRes.tender.OrgId).
+					On("Permission", tt.args.ctx, tt.args.username, tt.tenderRes.tender.OrgId, models.ActionApprove).
 					Return(tt.permissionRes.err)
 			}
 			if tt.userIdRes != nil {
@@ -303,14 +687,25 @@ func TestDecision(t *testing.T) {
 					On("UserId", tt.args.ctx, tt.args.username).
 					Return(tt.userIdRes.id, tt.userIdRes.err)
 			}
+			if tt.authorPermissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, tt.bidRes.bid.AuthorId, models.ActionView).
+					Return(tt.authorPermissionRes.err)
+			}
 			if tt.insertDecRes != nil {
 				bStorage.
-					On("InsertDecision", tt.args.ctx, nil). // TODO
+					On("InsertDecision", tt.args.ctx, models.Decision{
+						UserId:     tt.userIdRes.id,
+						BidId:      tt.bidRes.bid.Id,
+						BidVersion: tt.bidRes.bid.Version,
+						Decision:   tt.args.decision,
+						Comment:    "looks fine",
+					}).
 					Return(tt.insertDecRes.err)
 			}
 			if tt.decisionsRes != nil {
 				bStorage.
-					On("Decisions", tt.args.ctx, tt.bidRes.bid.Id).
+					On("Decisions", tt.args.ctx, tt.bidRes.bid.Id, tt.bidRes.bid.Version).
 					Return(tt.decisionsRes.decisions, tt.decisionsRes.err)
 			}
 			if tt.orgSizeRes != nil {
@@ -318,16 +713,30 @@ func TestDecision(t *testing.T) {
 					On("OrgSize", tt.args.ctx, tt.tenderRes.tender.OrgId).
 					Return(tt.orgSizeRes.size, tt.orgSizeRes.err)
 			}
+			if tt.quorumRes != nil {
+				bStorage.
+					On("OrgQuorum", tt.args.ctx, tt.tenderRes.tender.OrgId).
+					Return(tt.quorumRes.quorum, tt.quorumRes.err)
+			}
 			if tt.updBidRes != nil {
 				bStorage.
-					On("UpdateBid", tt.args.ctx, nil). // TODO
+					On("UpdateBid", tt.args.ctx, tt.updBidRes.bid, tt.updBidRes.bid.Version).
 					Return(tt.updBidRes.err)
-
-				if tt.updBidRes.err == nil {
-					bStorage.
-						On("Commit", tt.args.ctx).
-						Return(nil)
-				}
+			}
+			if tt.closeTenderRes != nil {
+				tender.
+					On("Close", tt.args.ctx, tt.tenderRes.tender.Id).
+					Return(models.Tender{}, tt.closeTenderRes.err)
+			}
+			if tt.rejectBidsRes != nil {
+				bStorage.
+					On("TenderBidsSetStatus", tt.args.ctx, tt.tenderRes.tender.Id, tt.bidRes.bid.Id, models.BidRejected).
+					Return(tt.rejectBidsRes.err)
+			}
+			if tt.commit {
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
 			}
 			bStorage.
 				On("Rollback", tt.args.ctx).
@@ -336,13 +745,15 @@ func TestDecision(t *testing.T) {
 			bid := Bid{
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
-				userSrv:    user,
-				bidStorage: bStorage,
-				tenderSrv:  tender,
+				userSrv:             user,
+				bidStorage:          bStorage,
+				tenderSrv:           tender,
+				decisionTransitions: models.NewBidDecisionTransitions(),
 			}
 
-			res, err := bid.SubmitDecision(tt.args.ctx, tt.args.username, tt.args.bidId, tt.args.decision)
-			assert.Equal(t, tt.want.bid, res)
+			res, err := bid.SubmitDecision(tt.args.ctx, tt.args.bidId, tt.args.decision, "looks fine")
+			assert.Equal(t, tt.want.bid, res.BidOut)
+			assert.Equal(t, tt.tally, res.Tally)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
 			} else {
@@ -377,6 +788,10 @@ func TestSetStatus(t *testing.T) {
 	type permissionRes struct {
 		err error
 	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
 	type setStatusRes struct {
 		bid models.Bid
 		err error
@@ -388,29 +803,31 @@ func TestSetStatus(t *testing.T) {
 		bidsRes       *bidRes
 		userIdRes     *userIdRes
 		permissionRes *permissionRes
+		tenderRes     *tenderRes
 		setStatusRes  *setStatusRes
 		want          want
 	}{
 		{
 			name:        "main line user",
-			args:        args{username: "user", id: BID_UUID, status: models.BidCreated},
+			args:        args{username: "user", id: BID_UUID, status: models.BidPublished},
 			validateRes: &validateRes{nil},
 			bidsRes: &bidRes{models.Bid{
 				Id:        BID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.BidCanceled,
+				Status:    models.BidCreated,
 				BidBase: models.BidBase{
 					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
 				},
 			}, nil},
 			userIdRes: &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{Status: models.TenderPublished}, nil},
 			setStatusRes: &setStatusRes{models.Bid{
 				Id:        BID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.BidCreated,
+				Status:    models.BidPublished,
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.User,
@@ -420,7 +837,7 @@ func TestSetStatus(t *testing.T) {
 				Id:        BID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.BidCreated,
+				Status:    models.BidPublished,
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.User,
@@ -429,24 +846,25 @@ func TestSetStatus(t *testing.T) {
 		},
 		{
 			name:        "main line org",
-			args:        args{username: "user", id: BID_UUID, status: models.BidCreated},
+			args:        args{username: "user", id: BID_UUID, status: models.BidPublished},
 			validateRes: &validateRes{nil},
 			bidsRes: &bidRes{models.Bid{
 				Id:        BID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.BidCanceled,
+				Status:    models.BidCreated,
 				BidBase: models.BidBase{
 					AuthorType: models.Organization,
 					AuthorId:   AUTH_UUID,
 				},
 			}, nil},
 			permissionRes: &permissionRes{nil},
+			tenderRes:     &tenderRes{models.Tender{Status: models.TenderPublished}, nil},
 			setStatusRes: &setStatusRes{models.Bid{
 				Id:        BID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.BidCreated,
+				Status:    models.BidPublished,
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.Organization,
@@ -456,7 +874,7 @@ func TestSetStatus(t *testing.T) {
 				Id:        BID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.BidCreated,
+				Status:    models.BidPublished,
 				BidBase: models.BidBase{
 					AuthorId:   AUTH_UUID,
 					AuthorType: models.Organization,
@@ -464,32 +882,131 @@ func TestSetStatus(t *testing.T) {
 			}, nil},
 		},
 		{
-			name:        "bid not found",
-			args:        args{username: "name", id: BID_UUID, status: models.BidCreated},
+			name:        "cancel published",
+			args:        args{username: "user", id: BID_UUID, status: models.BidCanceled},
 			validateRes: &validateRes{nil},
-			bidsRes:     &bidRes{models.Bid{}, storage.ErrBidNotFound},
-			want:        want{models.BidOut{}, service.ErrBidNotFound},
+			bidsRes: &bidRes{models.Bid{
+				Id:     BID_UUID,
+				Status: models.BidPublished,
+				BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			setStatusRes: &setStatusRes{models.Bid{
+				Id:     BID_UUID,
+				Status: models.BidCanceled,
+				BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				},
+			}, nil},
+			want: want{models.BidOut{
+				Id:     BID_UUID,
+				Status: models.BidCanceled,
+				BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				},
+			}, nil},
 		},
 		{
-			name:        "no permissions user",
-			args:        args{username: "name", id: BID_UUID, status: models.BidCreated},
+			name:        "invalid transition",
+			args:        args{username: "user", id: BID_UUID, status: models.BidPublished},
 			validateRes: &validateRes{nil},
 			bidsRes: &bidRes{models.Bid{
+				Id:     BID_UUID,
+				Status: models.BidRejected,
 				BidBase: models.BidBase{
 					AuthorType: models.User,
 					AuthorId:   AUTH_UUID,
 				},
 			}, nil},
-			userIdRes: &userIdRes{ORG_UUID, nil},
-			want:      want{models.BidOut{}, service.ErrNotEnoughPrivileges},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrInvalidTransition},
 		},
 		{
-			name:        "no permissions org",
-			args:        args{username: "name", id: BID_UUID, status: models.BidCreated},
+			// Withdrawal goes through Withdraw only.
+			name:        "withdraw published",
+			args:        args{username: "user", id: BID_UUID, status: models.BidWithdrawn},
 			validateRes: &validateRes{nil},
 			bidsRes: &bidRes{models.Bid{
+				Id:     BID_UUID,
+				Status: models.BidPublished,
 				BidBase: models.BidBase{
-					AuthorType: models.Organization,
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name:        "publish on closed tender",
+			args:        args{username: "user", id: BID_UUID, status: models.BidPublished},
+			validateRes: &validateRes{nil},
+			bidsRes: &bidRes{models.Bid{
+				Id:     BID_UUID,
+				Status: models.BidCreated,
+				BidBase: models.BidBase{
+					TenderId:   TENDER_UUID,
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderClosed}, nil},
+			want:      want{models.BidOut{}, service.ErrTenderNotPublished},
+		},
+		{
+			name:        "publish after deadline",
+			args:        args{username: "user", id: BID_UUID, status: models.BidPublished},
+			validateRes: &validateRes{nil},
+			bidsRes: &bidRes{models.Bid{
+				Id:     BID_UUID,
+				Status: models.BidCreated,
+				BidBase: models.BidBase{
+					TenderId:   TENDER_UUID,
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{
+				Id:         TENDER_UUID,
+				Status:     models.TenderPublished,
+				TenderBase: models.TenderBase{Deadline: ptr.Ptr(time.Now().Add(-time.Hour))},
+			}, nil},
+			want: want{models.BidOut{}, service.ErrDeadlinePassed},
+		},
+		{
+			name:        "bid not found",
+			args:        args{username: "name", id: BID_UUID, status: models.BidCreated},
+			validateRes: &validateRes{nil},
+			bidsRes:     &bidRes{models.Bid{}, storage.ErrBidNotFound},
+			want:        want{models.BidOut{}, service.ErrBidNotFound},
+		},
+		{
+			name:        "no permissions user",
+			args:        args{username: "name", id: BID_UUID, status: models.BidCreated},
+			validateRes: &validateRes{nil},
+			bidsRes: &bidRes{models.Bid{
+				BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				},
+			}, nil},
+			userIdRes: &userIdRes{ORG_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name:        "no permissions org",
+			args:        args{username: "name", id: BID_UUID, status: models.BidCreated},
+			validateRes: &validateRes{nil},
+			bidsRes: &bidRes{models.Bid{
+				BidBase: models.BidBase{
+					AuthorType: models.Organization,
 					AuthorId:   AUTH_UUID,
 				},
 			}, nil},
@@ -501,14 +1018,15 @@ func TestSetStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			user := mocks.NewUserService(t)
 			bStorage := mocks.NewBidStorage(t)
+			tender := mocks.NewTenderService(t)
 
 			bStorage.
 				On("Begin", tt.args.ctx).
 				Return(tt.args.ctx, nil)
 			if tt.validateRes != nil {
 				user.
-					On("Validate", tt.args.ctx, tt.args.username).
-					Return(tt.validateRes.err)
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, tt.validateRes.err)
 			}
 			if tt.bidsRes != nil {
 				bStorage.
@@ -522,9 +1040,14 @@ func TestSetStatus(t *testing.T) {
 			}
 			if tt.permissionRes != nil {
 				user.
-					On("Permission", tt.args.ctx, tt.args.username, tt.bidsRes.bid.AuthorId).
+					On("Permission", tt.args.ctx, tt.args.username, tt.bidsRes.bid.AuthorId, models.ActionPublish).
 					Return(tt.permissionRes.err)
 			}
+			if tt.tenderRes != nil {
+				tender.
+					On("Tender", tt.args.ctx, tt.bidsRes.bid.TenderId).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
 			if tt.setStatusRes != nil {
 				bStorage.
 					On("BidSetStatus", tt.args.ctx, tt.args.id, tt.args.status).
@@ -543,11 +1066,13 @@ func TestSetStatus(t *testing.T) {
 			bid := Bid{
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
-				userSrv:    user,
-				bidStorage: bStorage,
+				userSrv:     user,
+				bidStorage:  bStorage,
+				tenderSrv:   tender,
+				transitions: models.NewBidTransitions(),
 			}
 
-			res, err := bid.SetStatus(tt.args.ctx, tt.args.username, tt.args.id, tt.args.status)
+			res, err := bid.SetStatus(tt.args.ctx, tt.args.id, tt.args.status)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want.bid, res)
@@ -563,6 +1088,7 @@ func TestEdit(t *testing.T) {
 		ctx      context.Context
 		username string
 		id       uuid.UUID
+		version  int32
 		patch    models.BidPatch
 	}
 	type want struct {
@@ -583,20 +1109,30 @@ func TestEdit(t *testing.T) {
 	type permissionRes struct {
 		err error
 	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
 	type updateRes struct {
 		err error
 	}
 	type saveBidSrc struct {
 		err error
 	}
+	type carryOverRes struct {
+		err error
+	}
 	tests := []struct {
 		name          string
 		args          args
+		keepDecisions bool
 		validateRes   *validateRes
 		bidRes        *bidRes
 		userIdRes     *userIdRes
 		permissionRes *permissionRes
+		tenderRes     *tenderRes
 		saveBidSrc    *saveBidSrc
+		carryOverRes  *carryOverRes
 		updateRes     *updateRes
 		want          want
 	}{
@@ -619,6 +1155,7 @@ func TestEdit(t *testing.T) {
 				},
 			}, nil},
 			permissionRes: &permissionRes{nil},
+			tenderRes:     &tenderRes{models.Tender{Status: models.TenderPublished}, nil},
 			updateRes:     &updateRes{nil},
 			saveBidSrc:    &saveBidSrc{nil},
 			want: want{models.BidOut{
@@ -652,6 +1189,7 @@ func TestEdit(t *testing.T) {
 				},
 			}, nil},
 			userIdRes:  &userIdRes{AUTH_UUID, nil},
+			tenderRes:  &tenderRes{models.Tender{Status: models.TenderPublished}, nil},
 			updateRes:  &updateRes{nil},
 			saveBidSrc: &saveBidSrc{nil},
 			want: want{models.BidOut{
@@ -666,10 +1204,174 @@ func TestEdit(t *testing.T) {
 				},
 			}, nil},
 		},
+		{
+			name: "keep decisions",
+			args: args{username: "user", id: BID_UUID, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			keepDecisions: true,
+			validateRes:   &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+					Name:       "old name",
+				},
+			}, nil},
+			userIdRes:    &userIdRes{AUTH_UUID, nil},
+			tenderRes:    &tenderRes{models.Tender{Status: models.TenderPublished}, nil},
+			updateRes:    &updateRes{nil},
+			saveBidSrc:   &saveBidSrc{nil},
+			carryOverRes: &carryOverRes{nil},
+			want: want{models.BidOut{
+				Id:      BID_UUID,
+				Version: 3,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+					Name:       "new name",
+				},
+			}, nil},
+		},
+		{
+			name: "version conflict",
+			args: args{username: "user", id: BID_UUID, version: 1, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+					Name:       "old name",
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrVersionConflict},
+		},
+		{
+			name: "approved bid",
+			args: args{username: "user", id: BID_UUID, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				Status:  models.BidApproved,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name: "rejected bid",
+			args: args{username: "user", id: BID_UUID, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				Status:  models.BidRejected,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name: "withdrawn bid",
+			args: args{username: "user", id: BID_UUID, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				Status:  models.BidWithdrawn,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name: "canceled bid",
+			args: args{username: "user", id: BID_UUID, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				Status:  models.BidCanceled,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name: "tender closed",
+			args: args{username: "user", id: BID_UUID, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+					TenderId:   TENDER_UUID,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderClosed}, nil},
+			want:      want{models.BidOut{}, service.ErrTenderNotPublished},
+		},
+		{
+			name: "deadline passed",
+			args: args{username: "user", id: BID_UUID, patch: models.BidPatch{
+				Name: ptr.Ptr("new name"),
+			}},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 2,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+					TenderId:   TENDER_UUID,
+				},
+			}, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			tenderRes: &tenderRes{models.Tender{
+				Id:         TENDER_UUID,
+				Status:     models.TenderPublished,
+				TenderBase: models.TenderBase{Deadline: ptr.Ptr(time.Now().Add(-time.Hour))},
+			}, nil},
+			want: want{models.BidOut{}, service.ErrDeadlinePassed},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
 			bStorage := mocks.NewBidStorage(t)
 			rollbackSrv := mocks.NewRollbackService(t)
 
@@ -678,8 +1380,8 @@ func TestEdit(t *testing.T) {
 				Return(tt.args.ctx, nil)
 			if tt.validateRes != nil {
 				user.
-					On("Validate", tt.args.ctx, tt.args.username).
-					Return(tt.validateRes.err)
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, tt.validateRes.err)
 			}
 			if tt.bidRes != nil {
 				bStorage.
@@ -693,28 +1395,37 @@ func TestEdit(t *testing.T) {
 			}
 			if tt.permissionRes != nil {
 				user.
-					On("Permission", tt.args.ctx, tt.args.username, tt.bidRes.bid.AuthorId).
+					On("Permission", tt.args.ctx, tt.args.username, tt.bidRes.bid.AuthorId, models.ActionEdit).
 					Return(tt.permissionRes.err)
 			}
+			if tt.tenderRes != nil {
+				tenderSrv.
+					On("Tender", tt.args.ctx, tt.bidRes.bid.TenderId).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
 			if tt.updateRes != nil {
 				newBid := tt.bidRes.bid
 				newBid.Patch(tt.args.patch)
 				newBid.Version += 1
 
 				bStorage.
-					On("UpdateBid", tt.args.ctx, newBid).
+					On("UpdateBid", tt.args.ctx, newBid, tt.bidRes.bid.Version).
 					Return(tt.updateRes.err)
 			}
 			if tt.saveBidSrc != nil {
 				rollbackSrv.
 					On("SaveBid", tt.args.ctx, tt.bidRes.bid).
 					Return(tt.saveBidSrc.err)
-
-				if tt.saveBidSrc.err == nil {
-					bStorage.
-						On("Commit", tt.args.ctx).
-						Return(nil)
-				}
+			}
+			if tt.carryOverRes != nil {
+				bStorage.
+					On("CarryOverDecisions", tt.args.ctx, tt.args.id, tt.bidRes.bid.Version, tt.bidRes.bid.Version+1).
+					Return(tt.carryOverRes.err)
+			}
+			if tt.saveBidSrc != nil && tt.saveBidSrc.err == nil {
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
 			}
 			bStorage.
 				On("Rollback", tt.args.ctx).
@@ -723,12 +1434,14 @@ func TestEdit(t *testing.T) {
 			bid := Bid{
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
-				userSrv:     user,
-				bidStorage:  bStorage,
-				rollbackSrv: rollbackSrv,
+				userSrv:       user,
+				tenderSrv:     tenderSrv,
+				bidStorage:    bStorage,
+				rollbackSrv:   rollbackSrv,
+				keepDecisions: tt.keepDecisions,
 			}
 
-			res, err := bid.Edit(tt.args.ctx, tt.args.username, tt.args.id, tt.args.patch)
+			res, err := bid.Edit(tt.args.ctx, tt.args.id, tt.args.version, tt.args.patch)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want.bid, res)
@@ -739,64 +1452,562 @@ func TestEdit(t *testing.T) {
 	}
 }
 
-func TestReviews(t *testing.T) {
+func TestRollback(t *testing.T) {
 	type args struct {
-		ctx               context.Context
-		requester, author string
-		tenderId          uuid.UUID
-		limit, offset     int32
+		ctx      context.Context
+		username string
+		id       uuid.UUID
+		version  int32
 	}
 	type want struct {
-		reviews []models.ReviewOut
-		err     error
+		bid models.BidOut
+		err error
 	}
-	type validateRes struct {
+	type swapRes struct {
+		bid models.Bid
+		err error
+	}
+	type updateRes struct {
 		err error
 	}
 	type tenderRes struct {
 		tender models.Tender
 		err    error
 	}
-	type permissionRes struct {
-		err error
+	published := models.Tender{Id: TENDER_UUID, Status: models.TenderPublished}
+	actual := models.Bid{
+		Id:      BID_UUID,
+		Version: 3,
+		Status:  models.BidPublished,
+		BidBase: models.BidBase{
+			AuthorId:   AUTH_UUID,
+			AuthorType: models.User,
+			TenderId:   TENDER_UUID,
+			Name:       "new name",
+		},
 	}
-	type reviewsRes struct {
-		reviews []models.Review
-		err     error
+	old := models.Bid{
+		Id:      BID_UUID,
+		Version: 1,
+		Status:  models.BidCreated,
+		BidBase: models.BidBase{
+			AuthorId:   AUTH_UUID,
+			AuthorType: models.User,
+			TenderId:   TENDER_UUID,
+			Name:       "old name",
+		},
+	}
+	recovered := models.Bid{
+		Id:      BID_UUID,
+		Version: 4,
+		Status:  models.BidPublished,
+		BidBase: models.BidBase{
+			AuthorId:   AUTH_UUID,
+			AuthorType: models.User,
+			TenderId:   TENDER_UUID,
+			Name:       "old name",
+		},
 	}
 	tests := []struct {
 		name          string
 		args          args
-		valReqRes     *validateRes
-		valAuthRes    *validateRes
+		keepDecisions bool
+		strictBudget  bool
+		status        models.BidStatus
 		tenderRes     *tenderRes
-		permissionRes *permissionRes
-		reviewsRes    *reviewsRes
+		swapRes       *swapRes
+		updateRes     *updateRes
+		carryOver     bool
 		want          want
 	}{
 		{
-			name: "main line",
-			args: args{
-				requester: "user1",
-				author:    "user2",
-				tenderId:  TENDER_UUID,
-				limit:     3,
-				offset:    0,
-			},
-			valReqRes:  &validateRes{nil},
-			valAuthRes: &validateRes{nil},
-			tenderRes: &tenderRes{models.Tender{
-				Id:        TENDER_UUID,
-				Version:   2,
-				CreatedAt: time.Unix(10, 0),
-				TenderBase: models.TenderBase{
-					OrgId: ORG_UUID,
-					Desc:  "desc",
-					Name:  "name",
-				},
-			}, nil},
-			permissionRes: &permissionRes{nil},
-			reviewsRes: &reviewsRes{[]models.Review{
+			name:      "reset decisions",
+			args:      args{context.Background(), "user", BID_UUID, 1},
+			tenderRes: &tenderRes{published, nil},
+			swapRes:   &swapRes{old, nil},
+			updateRes: &updateRes{nil},
+			want:      want{recovered.ToOut(), nil},
+		},
+		{
+			name:          "keep decisions",
+			args:          args{context.Background(), "user", BID_UUID, 1},
+			keepDecisions: true,
+			tenderRes:     &tenderRes{published, nil},
+			swapRes:       &swapRes{old, nil},
+			updateRes:     &updateRes{nil},
+			carryOver:     true,
+			want:          want{recovered.ToOut(), nil},
+		},
+		{
+			name:      "version not found",
+			args:      args{context.Background(), "user", BID_UUID, 7},
+			tenderRes: &tenderRes{published, nil},
+			swapRes:   &swapRes{models.Bid{}, service.ErrVersionNotFound},
+			want:      want{models.BidOut{}, service.ErrVersionNotFound},
+		},
+		{
+			name:      "modified concurrently",
+			args:      args{context.Background(), "user", BID_UUID, 1},
+			tenderRes: &tenderRes{published, nil},
+			swapRes:   &swapRes{old, nil},
+			updateRes: &updateRes{storage.ErrVersionConflict},
+			want:      want{models.BidOut{}, service.ErrVersionConflict},
+		},
+		{
+			name:   "approved bid",
+			args:   args{context.Background(), "user", BID_UUID, 1},
+			status: models.BidApproved,
+			want:   want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name:   "rejected bid",
+			args:   args{context.Background(), "user", BID_UUID, 1},
+			status: models.BidRejected,
+			want:   want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name:   "withdrawn bid",
+			args:   args{context.Background(), "user", BID_UUID, 1},
+			status: models.BidWithdrawn,
+			want:   want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name:   "canceled bid",
+			args:   args{context.Background(), "user", BID_UUID, 1},
+			status: models.BidCanceled,
+			want:   want{models.BidOut{}, service.ErrInvalidTransition},
+		},
+		{
+			name:      "tender closed",
+			args:      args{context.Background(), "user", BID_UUID, 1},
+			tenderRes: &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderClosed}, nil},
+			want:      want{models.BidOut{}, service.ErrTenderNotPublished},
+		},
+		{
+			name: "deadline passed",
+			args: args{context.Background(), "user", BID_UUID, 1},
+			tenderRes: &tenderRes{models.Tender{
+				Id:         TENDER_UUID,
+				Status:     models.TenderPublished,
+				TenderBase: models.TenderBase{Deadline: ptr.Ptr(time.Now().Add(-time.Hour))},
+			}, nil},
+			want: want{models.BidOut{}, service.ErrDeadlinePassed},
+		},
+		{
+			// Old version was priced before tender's budget was lowered.
+			name:         "recovered price over budget",
+			args:         args{context.Background(), "user", BID_UUID, 1},
+			strictBudget: true,
+			tenderRes: &tenderRes{models.Tender{
+				Id:         TENDER_UUID,
+				Status:     models.TenderPublished,
+				TenderBase: models.TenderBase{TenderTerms: models.TenderTerms{Budget: ptr.Ptr[int64](1000), Currency: ptr.Ptr("USD")}},
+			}, nil},
+			swapRes: &swapRes{models.Bid{
+				Id:      BID_UUID,
+				Version: 1,
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.User,
+					TenderId:   TENDER_UUID,
+					BidTerms:   models.BidTerms{Price: ptr.Ptr[int64](1500), Currency: ptr.Ptr("USD")},
+				},
+			}, nil},
+			want: want{models.BidOut{}, service.ErrOverBudget},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			bStorage := mocks.NewBidStorage(t)
+			rollbackSrv := mocks.NewRollbackService(t)
+
+			bStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			current := actual
+			if tt.status != "" {
+				current.Status = tt.status
+			}
+			bStorage.
+				On("Bid", tt.args.ctx, tt.args.id).
+				Return(current, nil)
+			user.
+				On("UserId", tt.args.ctx, tt.args.username).
+				Return(AUTH_UUID, nil)
+			if tt.tenderRes != nil {
+				tenderSrv.
+					On("Tender", tt.args.ctx, actual.TenderId).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
+			if tt.swapRes != nil {
+				rollbackSrv.
+					On("SwapBid", tt.args.ctx, tt.args.id, tt.args.version, actual).
+					Return(tt.swapRes.bid, tt.swapRes.err)
+			}
+			if tt.updateRes != nil {
+				// Recovered bid replaces actual one, so its id is kept.
+				bStorage.
+					On("UpdateBid", tt.args.ctx, recovered, actual.Version).
+					Return(tt.updateRes.err)
+			}
+			if tt.carryOver {
+				bStorage.
+					On("CarryOverDecisions", tt.args.ctx, tt.args.id, actual.Version, recovered.Version).
+					Return(nil)
+			}
+			if tt.want.err == nil {
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			bStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			bid := Bid{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:       user,
+				tenderSrv:     tenderSrv,
+				bidStorage:    bStorage,
+				rollbackSrv:   rollbackSrv,
+				strictBudget:  tt.strictBudget,
+				keepDecisions: tt.keepDecisions,
+			}
+
+			res, err := bid.Rollback(tt.args.ctx, tt.args.id, tt.args.version)
+			assert.Equal(t, tt.want.bid, res)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		id       uuid.UUID
+		from, to int32
+	}
+	type want struct {
+		diff models.Diff
+		err  error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type versionRes struct {
+		bid models.Bid
+		err error
+	}
+	byUser := models.Bid{
+		Id:      BID_UUID,
+		Version: 2,
+		Status:  models.BidPublished,
+		BidBase: models.BidBase{AuthorId: AUTH_UUID, AuthorType: models.User, Name: "new name"},
+	}
+	byOrg := models.Bid{
+		Id:      BID_UUID,
+		Version: 2,
+		Status:  models.BidPublished,
+		BidBase: models.BidBase{AuthorId: ORG_UUID, AuthorType: models.Organization, Name: "new name"},
+	}
+	old := models.Bid{
+		Id:      BID_UUID,
+		Version: 1,
+		Status:  models.BidCreated,
+		BidBase: models.BidBase{AuthorId: AUTH_UUID, AuthorType: models.User, Name: "name"},
+	}
+	tests := []struct {
+		name          string
+		args          args
+		bid           models.Bid
+		userId        uuid.UUID
+		permissionRes *permissionRes
+		versionRes    *versionRes
+		want          want
+	}{
+		{
+			name:       "user author",
+			args:       args{context.Background(), "user", BID_UUID, 1, 2},
+			bid:        byUser,
+			userId:     AUTH_UUID,
+			versionRes: &versionRes{old, nil},
+			want: want{models.Diff{From: 1, To: 2, Changes: []models.FieldDiff{
+				{Field: "name", From: "name", To: "new name"},
+				{Field: "status", From: models.BidCreated, To: models.BidPublished},
+			}}, nil},
+		},
+		{
+			name:   "not author",
+			args:   args{context.Background(), "user", BID_UUID, 1, 2},
+			bid:    byUser,
+			userId: BID_UUID2,
+			want:   want{models.Diff{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name:          "org author",
+			args:          args{context.Background(), "user", BID_UUID, 2, 2},
+			bid:           byOrg,
+			permissionRes: &permissionRes{nil},
+			want:          want{models.Diff{From: 2, To: 2, Changes: []models.FieldDiff{}}, nil},
+		},
+		{
+			name:          "not org member",
+			args:          args{context.Background(), "user", BID_UUID, 1, 2},
+			bid:           byOrg,
+			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
+			want:          want{models.Diff{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name:       "version not found",
+			args:       args{context.Background(), "user", BID_UUID, 7, 2},
+			bid:        byUser,
+			userId:     AUTH_UUID,
+			versionRes: &versionRes{models.Bid{}, service.ErrVersionNotFound},
+			want:       want{models.Diff{}, service.ErrVersionNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			bStorage := mocks.NewBidStorage(t)
+			rollbackSrv := mocks.NewRollbackService(t)
+
+			bStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			bStorage.
+				On("Bid", tt.args.ctx, tt.args.id).
+				Return(tt.bid, nil)
+			if tt.bid.AuthorType == models.User {
+				user.
+					On("UserId", tt.args.ctx, tt.args.username).
+					Return(tt.userId, nil)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, tt.bid.AuthorId, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.versionRes != nil {
+				// Actual version is not requested from rollback.
+				rollbackSrv.
+					On("BidVersion", tt.args.ctx, tt.args.id, tt.args.from).
+					Return(tt.versionRes.bid, tt.versionRes.err)
+			}
+			if tt.want.err == nil {
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			bStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			bid := Bid{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:     user,
+				bidStorage:  bStorage,
+				rollbackSrv: rollbackSrv,
+			}
+
+			res, err := bid.Diff(tt.args.ctx, tt.args.id, tt.args.from, tt.args.to)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.diff, res)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		tenderId uuid.UUID
+	}
+	type want struct {
+		bids []models.BidOut
+		err  error
+	}
+	type tenderRes struct {
+		err error
+	}
+	type bidsRes struct {
+		bids []models.Bid
+		err  error
+	}
+	cheap := models.Bid{
+		Id:      BID_UUID,
+		Version: 1,
+		Status:  models.BidPublished,
+		BidBase: models.BidBase{
+			TenderId: TENDER_UUID,
+			BidTerms: models.BidTerms{Price: ptr.Ptr(int64(1000)), Currency: ptr.Ptr("RUB")},
+		},
+	}
+	expensive := models.Bid{
+		Id:      BID_UUID2,
+		Version: 2,
+		Status:  models.BidPublished,
+		BidBase: models.BidBase{
+			TenderId: TENDER_UUID,
+			BidTerms: models.BidTerms{Price: ptr.Ptr(int64(5000)), Currency: ptr.Ptr("RUB")},
+		},
+	}
+	tests := []struct {
+		name      string
+		args      args
+		tenderRes *tenderRes
+		bidsRes   *bidsRes
+		want      want
+	}{
+		{
+			name:      "main line",
+			args:      args{context.Background(), "user", TENDER_UUID},
+			tenderRes: &tenderRes{nil},
+			bidsRes:   &bidsRes{[]models.Bid{cheap, expensive}, nil},
+			want:      want{[]models.BidOut{cheap.ToOut(), expensive.ToOut()}, nil},
+		},
+		{
+			name:      "no bids",
+			args:      args{context.Background(), "user", TENDER_UUID},
+			tenderRes: &tenderRes{nil},
+			bidsRes:   &bidsRes{nil, nil},
+			want:      want{[]models.BidOut{}, nil},
+		},
+		{
+			name:      "tender not found",
+			args:      args{context.Background(), "user", TENDER_UUID},
+			tenderRes: &tenderRes{service.ErrTenderNotFound},
+			want:      want{nil, service.ErrTenderNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			bStorage := mocks.NewBidStorage(t)
+
+			bStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.tenderRes != nil {
+				tenderSrv.
+					On("Tender", tt.args.ctx, tt.args.tenderId).
+					Return(models.Tender{Id: tt.args.tenderId}, tt.tenderRes.err)
+			}
+			if tt.bidsRes != nil {
+				bStorage.
+					On("PublishedBidsByPrice", tt.args.ctx, tt.args.tenderId).
+					Return(tt.bidsRes.bids, tt.bidsRes.err)
+			}
+			if tt.want.err == nil {
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			bStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			bid := Bid{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:    user,
+				tenderSrv:  tenderSrv,
+				bidStorage: bStorage,
+			}
+
+			res, err := bid.Compare(tt.args.ctx, tt.args.tenderId)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.bids, res)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestReviews(t *testing.T) {
+	type args struct {
+		ctx               context.Context
+		requester, author string
+		tenderId          uuid.UUID
+		limit, offset     int32
+		after             *models.Cursor
+	}
+	type want struct {
+		reviews []models.ReviewOut
+		err     error
+	}
+	type validateRes struct {
+		err error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type reviewsRes struct {
+		reviews []models.Review
+		err     error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		valReqRes     *validateRes
+		valAuthRes    *validateRes
+		tenderRes     *tenderRes
+		permissionRes *permissionRes
+		reviewsRes    *reviewsRes
+		want          want
+	}{
+		{
+			name: "main line",
+			args: args{
+				requester: "user1",
+				author:    "user2",
+				tenderId:  TENDER_UUID,
+				limit:     3,
+				offset:    0,
+			},
+			valReqRes:  &validateRes{nil},
+			valAuthRes: &validateRes{nil},
+			tenderRes: &tenderRes{models.Tender{
+				Id:        TENDER_UUID,
+				Version:   2,
+				CreatedAt: time.Unix(10, 0),
+				TenderBase: models.TenderBase{
+					OrgId: ORG_UUID,
+					Desc:  "desc",
+					Name:  "name",
+				},
+			}, nil},
+			permissionRes: &permissionRes{nil},
+			reviewsRes: &reviewsRes{[]models.Review{
 				{
 					BidId: BID_UUID,
 					ReviewBase: models.ReviewBase{
@@ -806,89 +2017,511 @@ func TestReviews(t *testing.T) {
 					},
 				},
 			}, nil},
-			want: want{
-				[]models.ReviewOut{
-					{
-						ReviewBase: models.ReviewBase{
-							Id:        REVIEW_UUID,
-							Desc:      "desc",
-							CreatedAt: time.Unix(32, 0),
-						},
-					},
+			want: want{
+				[]models.ReviewOut{
+					{
+						ReviewBase: models.ReviewBase{
+							Id:        REVIEW_UUID,
+							Desc:      "desc",
+							CreatedAt: time.Unix(32, 0),
+						},
+					},
+				},
+				nil,
+			},
+		},
+		{
+			name:      "requester not found",
+			args:      args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
+			valReqRes: &validateRes{service.ErrUserNotFound},
+			want:      want{nil, service.ErrUserNotFound},
+		},
+		{
+			name:       "author not found",
+			args:       args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
+			valReqRes:  &validateRes{nil},
+			valAuthRes: &validateRes{service.ErrUserNotFound},
+			want:       want{nil, service.ErrAuthorNotFound},
+		},
+		{
+			name:       "tender not found",
+			args:       args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
+			valReqRes:  &validateRes{nil},
+			valAuthRes: &validateRes{nil},
+			tenderRes:  &tenderRes{models.Tender{}, service.ErrTenderNotFound},
+			want:       want{nil, service.ErrTenderNotFound},
+		},
+		{
+			name:          "no permissions for requester",
+			args:          args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
+			valReqRes:     &validateRes{nil},
+			valAuthRes:    &validateRes{nil},
+			tenderRes:     &tenderRes{models.Tender{}, nil},
+			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
+			want:          want{nil, service.ErrNotEnoughPrivileges},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			bStorage := mocks.NewBidStorage(t)
+			tender := mocks.NewTenderService(t)
+
+			bStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			if tt.valReqRes != nil {
+				user.
+					On("Caller", tt.args.ctx).
+					Return(tt.args.requester, tt.valReqRes.err)
+			}
+			if tt.valAuthRes != nil {
+				user.
+					On("Validate", tt.args.ctx, tt.args.author).
+					Return(tt.valAuthRes.err)
+			}
+			if tt.tenderRes != nil {
+				tender.
+					On("Tender", tt.args.ctx, tt.args.tenderId).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.requester, tt.tenderRes.tender.OrgId, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.reviewsRes != nil {
+				bStorage.
+					On("Reviews", tt.args.ctx, tt.args.tenderId, tt.args.author, tt.args.limit, tt.args.offset, tt.args.after).
+					Return(tt.reviewsRes.reviews, (*models.Cursor)(nil), tt.reviewsRes.err)
+
+				if tt.reviewsRes.err == nil {
+					bStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			bStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			bid := Bid{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:    user,
+				bidStorage: bStorage,
+				tenderSrv:  tender,
+			}
+
+			res, _, err := bid.Reviews(tt.args.ctx, tt.args.author, tt.args.tenderId, tt.args.limit, tt.args.offset, tt.args.after)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.reviews, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
+
+func TestFeedback(t *testing.T) {
+	type args struct {
+		ctx                context.Context
+		username, feedback string
+		bidId              uuid.UUID
+	}
+	type want struct {
+		bid models.BidOut
+		err error
+	}
+	type validateRes struct {
+		err error
+	}
+	type bidRes struct {
+		bid models.Bid
+		err error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type insertReviewRes struct {
+		id  uuid.UUID
+		err error
+	}
+	tests := []struct {
+		name            string
+		args            args
+		validateRes     *validateRes
+		bidRes          *bidRes
+		tenderRes       *tenderRes
+		permissionRes   *permissionRes
+		insertReviewRes *insertReviewRes
+		want            want
+	}{
+		{
+			name:        "main line org",
+			args:        args{username: "user", bidId: BID_UUID, feedback: "feedback"},
+			validateRes: &validateRes{nil},
+			bidRes: &bidRes{models.Bid{
+				Id:        BID_UUID,
+				Version:   3,
+				CreatedAt: time.Unix(34, 0),
+				BidBase: models.BidBase{
+					AuthorType: models.Organization,
+					AuthorId:   AUTH_UUID,
+					TenderId:   TENDER_UUID,
+				},
+			}, nil},
+			tenderRes: &tenderRes{models.Tender{
+				Version:   7,
+				CreatedAt: time.Unix(10, 0),
+				Id:        BID_UUID,
+				TenderBase: models.TenderBase{
+					OrgId: ORG_UUID,
 				},
-				nil,
-			},
+			}, nil},
+			permissionRes:   &permissionRes{nil},
+			insertReviewRes: &insertReviewRes{REVIEW_UUID, nil},
+			want: want{models.BidOut{
+				Id:        BID_UUID,
+				Version:   3,
+				CreatedAt: time.Unix(34, 0),
+				BidBase: models.BidBase{
+					AuthorId:   AUTH_UUID,
+					AuthorType: models.Organization,
+					TenderId:   TENDER_UUID,
+				},
+			}, nil},
 		},
 		{
-			name:      "requester not found",
-			args:      args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
-			valReqRes: &validateRes{service.ErrUserNotFound},
-			want:      want{nil, service.ErrUserNotFound},
+			name:        "bid not found",
+			args:        args{username: "user", bidId: BID_UUID, feedback: "feedback"},
+			validateRes: &validateRes{nil},
+			bidRes:      &bidRes{models.Bid{}, storage.ErrBidNotFound},
+			want:        want{models.BidOut{}, service.ErrBidNotFound},
 		},
 		{
-			name:       "author not found",
-			args:       args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
-			valReqRes:  &validateRes{nil},
-			valAuthRes: &validateRes{service.ErrUserNotFound},
-			want:       want{nil, service.ErrAuthorNotFound},
+			name:        "tender not found",
+			args:        args{username: "user", bidId: BID_UUID, feedback: "feedback"},
+			validateRes: &validateRes{nil},
+			bidRes:      &bidRes{models.Bid{}, nil},
+			tenderRes:   &tenderRes{models.Tender{}, service.ErrTenderNotFound},
+			want:        want{models.BidOut{}, service.ErrTenderNotFound},
+		},
+		{
+			name:          "user without permissions",
+			args:          args{username: "user", bidId: BID_UUID, feedback: "feedback"},
+			validateRes:   &validateRes{nil},
+			bidRes:        &bidRes{models.Bid{}, nil},
+			tenderRes:     &tenderRes{models.Tender{}, nil},
+			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
+			want:          want{models.BidOut{}, service.ErrNotEnoughPrivileges},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			bStorage := mocks.NewBidStorage(t)
+			tender := mocks.NewTenderService(t)
+
+			bStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			if tt.validateRes != nil {
+				user.
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, tt.validateRes.err)
+			}
+			if tt.bidRes != nil {
+				bStorage.
+					On("Bid", tt.args.ctx, tt.args.bidId).
+					Return(tt.bidRes.bid, tt.bidRes.err)
+			}
+			if tt.tenderRes != nil {
+				tender.
+					On("Tender", tt.args.ctx, tt.bidRes.bid.TenderId).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, tt.tenderRes.tender.OrgId, models.ActionReview).
+					Return(tt.permissionRes.err)
+			}
+			if tt.insertReviewRes != nil {
+				review := models.Review{
+					BidId: tt.bidRes.bid.Id,
+					ReviewBase: models.ReviewBase{
+						Desc: tt.args.feedback,
+					},
+					AuthorName: tt.args.username,
+				}
+
+				bStorage.
+					On("InsertReview", tt.args.ctx, review).
+					Return(tt.insertReviewRes.id, tt.insertReviewRes.err)
+
+				if tt.insertReviewRes.err == nil {
+					bStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			bStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			bid := Bid{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:    user,
+				bidStorage: bStorage,
+				tenderSrv:  tender,
+			}
+
+			res, err := bid.Feedback(tt.args.ctx, tt.args.bidId, tt.args.feedback)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.bid, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		id       uuid.UUID
+	}
+	type want struct {
+		bid models.BidOut
+		err error
+	}
+	type bidRes struct {
+		bid models.Bid
+		err error
+	}
+	type userIdRes struct {
+		id  uuid.UUID
+		err error
+	}
+	type tenderPermissionRes struct {
+		err error
+	}
+	draft := models.Bid{
+		Id:     BID_UUID,
+		Status: models.BidCreated,
+		BidBase: models.BidBase{
+			AuthorId:   AUTH_UUID,
+			AuthorType: models.User,
+			TenderId:   TENDER_UUID,
+		},
+	}
+	published := draft
+	published.Status = models.BidPublished
+	tests := []struct {
+		name                string
+		args                args
+		bidRes              *bidRes
+		userIdRes           *userIdRes
+		tenderPermissionRes *tenderPermissionRes
+		want                want
+	}{
+		{
+			name:   "public bid",
+			args:   args{context.Background(), "competitor", BID_UUID},
+			bidRes: &bidRes{published, nil},
+			want:   want{published.ToOut(), nil},
+		},
+		{
+			name:      "draft seen by author",
+			args:      args{context.Background(), "author", BID_UUID},
+			bidRes:    &bidRes{draft, nil},
+			userIdRes: &userIdRes{AUTH_UUID, nil},
+			want:      want{draft.ToOut(), nil},
+		},
+		{
+			name:                "draft seen by tender responsible",
+			args:                args{context.Background(), "responsible", BID_UUID},
+			bidRes:              &bidRes{draft, nil},
+			userIdRes:           &userIdRes{BID_UUID2, nil},
+			tenderPermissionRes: &tenderPermissionRes{nil},
+			want:                want{draft.ToOut(), nil},
+		},
+		{
+			name:                "draft hidden from competitor",
+			args:                args{context.Background(), "competitor", BID_UUID},
+			bidRes:              &bidRes{draft, nil},
+			userIdRes:           &userIdRes{BID_UUID2, nil},
+			tenderPermissionRes: &tenderPermissionRes{&service.PermissionError{Action: models.ActionView}},
+			want:                want{models.BidOut{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name:   "bid not found",
+			args:   args{context.Background(), "competitor", BID_UUID},
+			bidRes: &bidRes{models.Bid{}, storage.ErrBidNotFound},
+			want:   want{models.BidOut{}, service.ErrBidNotFound},
 		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			bStorage := mocks.NewBidStorage(t)
+
+			bStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.bidRes != nil {
+				bStorage.
+					On("Bid", tt.args.ctx, tt.args.id).
+					Return(tt.bidRes.bid, tt.bidRes.err)
+			}
+			if tt.userIdRes != nil {
+				user.
+					On("UserId", tt.args.ctx, tt.args.username).
+					Return(tt.userIdRes.id, tt.userIdRes.err)
+			}
+			if tt.tenderPermissionRes != nil {
+				tenderSrv.
+					On("Tender", tt.args.ctx, tt.bidRes.bid.TenderId).
+					Return(models.Tender{Id: TENDER_UUID, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil)
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
+					Return(tt.tenderPermissionRes.err)
+			}
+			if tt.want.err == nil {
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			bStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			bid := Bid{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:    user,
+				tenderSrv:  tenderSrv,
+				bidStorage: bStorage,
+			}
+
+			res, err := bid.Get(tt.args.ctx, tt.args.id)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.bid, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
+
+func TestDecisionLog(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		id       uuid.UUID
+	}
+	type want struct {
+		decisions []models.DecisionOut
+		err       error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type logRes struct {
+		decisions []models.Decision
+		err       error
+	}
+	decision := models.Decision{
+		Id:         BID_UUID2,
+		UserId:     AUTH_UUID,
+		Username:   "responsible",
+		BidId:      BID_UUID,
+		BidVersion: 2,
+		Decision:   models.Approved,
+		Comment:    "good price",
+		CreatedAt:  time.Unix(10000, 0),
+	}
+	// Decision kept after bid was edited.
+	carried := decision
+	carried.Id = REVIEW_UUID
+	carried.BidVersion = 3
+	carried.CarriedFromVersion = ptr.Ptr(int32(2))
+	carried.CreatedAt = time.Unix(20000, 0)
+	tests := []struct {
+		name          string
+		args          args
+		permissionRes *permissionRes
+		logRes        *logRes
+		want          want
+	}{
 		{
-			name:       "tender not found",
-			args:       args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
-			valReqRes:  &validateRes{nil},
-			valAuthRes: &validateRes{nil},
-			tenderRes:  &tenderRes{models.Tender{}, service.ErrTenderNotFound},
-			want:       want{nil, service.ErrTenderNotFound},
+			name:          "main line",
+			args:          args{context.Background(), "responsible", BID_UUID},
+			permissionRes: &permissionRes{nil},
+			logRes:        &logRes{[]models.Decision{decision, carried}, nil},
+			want: want{[]models.DecisionOut{
+				decision.ToOut(),
+				{
+					Id:                 REVIEW_UUID,
+					Username:           "responsible",
+					BidVersion:         3,
+					CarriedFromVersion: ptr.Ptr(int32(2)),
+					Decision:           models.Approved,
+					Comment:            "good price",
+					CreatedAt:          time.Unix(20000, 0),
+				},
+			}, nil},
 		},
 		{
-			name:          "no permissions for requester",
-			args:          args{requester: "user1", author: "user2", tenderId: TENDER_UUID, limit: 5},
-			valReqRes:     &validateRes{nil},
-			valAuthRes:    &validateRes{nil},
-			tenderRes:     &tenderRes{models.Tender{}, nil},
-			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
+			name:          "not responsible",
+			args:          args{context.Background(), "competitor", BID_UUID},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
 			want:          want{nil, service.ErrNotEnoughPrivileges},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
 			bStorage := mocks.NewBidStorage(t)
-			tender := mocks.NewTenderService(t)
 
 			bStorage.
 				On("Begin", tt.args.ctx).
 				Return(tt.args.ctx, nil)
-			if tt.valReqRes != nil {
-				user.
-					On("Validate", tt.args.ctx, tt.args.requester).
-					Return(tt.valReqRes.err)
-			}
-			if tt.valAuthRes != nil {
-				user.
-					On("Validate", tt.args.ctx, tt.args.author).
-					Return(tt.valAuthRes.err)
-			}
-			if tt.tenderRes != nil {
-				tender.
-					On("Tender", tt.args.ctx, tt.args.tenderId).
-					Return(tt.tenderRes.tender, tt.tenderRes.err)
-			}
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			bStorage.
+				On("Bid", tt.args.ctx, tt.args.id).
+				Return(models.Bid{Id: BID_UUID, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil)
+			tenderSrv.
+				On("Tender", tt.args.ctx, TENDER_UUID).
+				Return(models.Tender{Id: TENDER_UUID, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil)
 			if tt.permissionRes != nil {
 				user.
-					On("Permission", tt.args.ctx, tt.args.requester, tt.tenderRes.tender.OrgId).
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
 					Return(tt.permissionRes.err)
 			}
-			if tt.reviewsRes != nil {
+			if tt.logRes != nil {
 				bStorage.
-					On("Reviews", tt.args.ctx, tt.args.tenderId, tt.args.author, tt.args.limit, tt.args.offset).
-					Return(tt.reviewsRes.reviews, tt.reviewsRes.err)
-
-				if tt.reviewsRes.err == nil {
-					bStorage.
-						On("Commit", tt.args.ctx).
-						Return(nil)
-				}
+					On("DecisionLog", tt.args.ctx, tt.args.id, int32(5), int32(0), (*models.Cursor)(nil)).
+					Return(tt.logRes.decisions, nil, tt.logRes.err)
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
 			}
 			bStorage.
 				On("Rollback", tt.args.ctx).
@@ -898,167 +2531,338 @@ func TestReviews(t *testing.T) {
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
 				userSrv:    user,
+				tenderSrv:  tenderSrv,
 				bidStorage: bStorage,
-				tenderSrv:  tender,
 			}
 
-			res, err := bid.Reviews(tt.args.ctx, tt.args.requester, tt.args.author, tt.args.tenderId, tt.args.limit, tt.args.offset)
+			res, next, err := bid.Decisions(tt.args.ctx, tt.args.id, 5, 0, nil)
+			assert.Nil(t, next)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.want.reviews, res)
+				assert.Equal(t, tt.want.decisions, res)
 			} else {
-				assert.EqualError(t, err, tt.want.err.Error())
+				assert.ErrorIs(t, err, tt.want.err)
 			}
 		})
 	}
 }
 
-func TestFeedback(t *testing.T) {
+func TestWithdraw(t *testing.T) {
 	type args struct {
-		ctx                context.Context
-		username, feedback string
-		bidId              uuid.UUID
+		ctx      context.Context
+		username string
+		id       uuid.UUID
 	}
 	type want struct {
-		bid models.BidOut
+		bid models.BidWithdrawalOut
 		err error
 	}
-	type validateRes struct {
+	type updateRes struct {
 		err error
 	}
-	type bidRes struct {
-		bid models.Bid
+	type insertRes struct {
+		withdrawal models.Withdrawal
+		err        error
+	}
+	withdrawnAt := time.Unix(10000, 0)
+	tests := []struct {
+		name          string
+		args          args
+		bid           models.Bid
+		keepDecisions bool
+		updateRes     *updateRes
+		insertRes     *insertRes
+		want          want
+	}{
+		{
+			name: "main line",
+			args: args{context.Background(), "user", BID_UUID},
+			bid: models.Bid{Id: BID_UUID, Version: 1, Status: models.BidPublished, BidBase: models.BidBase{
+				AuthorType: models.User,
+				AuthorId:   AUTH_UUID,
+			}},
+			keepDecisions: true,
+			updateRes:     &updateRes{nil},
+			insertRes: &insertRes{models.Withdrawal{
+				Id:          BID_UUID2,
+				BidId:       BID_UUID,
+				UserId:      AUTH_UUID,
+				Reason:      "price changed",
+				WithdrawnAt: withdrawnAt,
+			}, nil},
+			want: want{models.BidWithdrawalOut{
+				BidOut: models.BidOut{Id: BID_UUID, Version: 2, Status: models.BidWithdrawn, BidBase: models.BidBase{
+					AuthorType: models.User,
+					AuthorId:   AUTH_UUID,
+				}},
+				Withdrawal: &models.WithdrawalOut{
+					Id:          BID_UUID2,
+					Reason:      "price changed",
+					WithdrawnAt: withdrawnAt,
+				},
+			}, nil},
+		},
+		{
+			name: "modified concurrently",
+			args: args{context.Background(), "user", BID_UUID},
+			bid: models.Bid{Id: BID_UUID, Version: 1, Status: models.BidPublished, BidBase: models.BidBase{
+				AuthorType: models.User,
+				AuthorId:   AUTH_UUID,
+			}},
+			updateRes: &updateRes{storage.ErrVersionConflict},
+			want:      want{models.BidWithdrawalOut{}, service.ErrVersionConflict},
+		},
+		{
+			name: "not author",
+			args: args{context.Background(), "user", BID_UUID},
+			bid: models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{
+				AuthorType: models.User,
+				AuthorId:   uuid.New(),
+			}},
+			want: want{models.BidWithdrawalOut{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name: "draft",
+			args: args{context.Background(), "user", BID_UUID},
+			bid: models.Bid{Id: BID_UUID, Status: models.BidCreated, BidBase: models.BidBase{
+				AuthorType: models.User,
+				AuthorId:   AUTH_UUID,
+			}},
+			want: want{models.BidWithdrawalOut{}, service.ErrInvalidTransition},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			bStorage := mocks.NewBidStorage(t)
+			rollbackSrv := mocks.NewRollbackService(t)
+
+			bStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			bStorage.
+				On("Bid", tt.args.ctx, tt.args.id).
+				Return(tt.bid, nil)
+			user.
+				On("UserId", tt.args.ctx, tt.args.username).
+				Return(AUTH_UUID, nil)
+			if tt.updateRes != nil {
+				newBid := tt.bid
+				newBid.Status = models.BidWithdrawn
+				newBid.Version += 1
+				bStorage.
+					On("UpdateBid", tt.args.ctx, newBid, tt.bid.Version).
+					Return(tt.updateRes.err)
+				if tt.updateRes.err == nil {
+					rollbackSrv.
+						On("SaveBid", tt.args.ctx, tt.bid).
+						Return(nil)
+				}
+				if tt.updateRes.err == nil && tt.keepDecisions {
+					bStorage.
+						On("CarryOverDecisions", tt.args.ctx, tt.args.id, tt.bid.Version, newBid.Version).
+						Return(nil)
+				}
+			}
+			if tt.insertRes != nil {
+				bStorage.
+					On("InsertWithdrawal", tt.args.ctx, models.Withdrawal{
+						BidId:  tt.args.id,
+						UserId: AUTH_UUID,
+						Reason: "price changed",
+					}).
+					Return(tt.insertRes.withdrawal, tt.insertRes.err)
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			bStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			bid := Bid{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:             user,
+				bidStorage:          bStorage,
+				rollbackSrv:         rollbackSrv,
+				withdrawTransitions: models.NewBidWithdrawTransitions(),
+				keepDecisions:       tt.keepDecisions,
+			}
+
+			res, err := bid.Withdraw(tt.args.ctx, tt.args.id, models.WithdrawalNew{Reason: "price changed"})
+			assert.Equal(t, tt.want.bid, res)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestResubmit(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		id       uuid.UUID
+	}
+	type want struct {
+		bid models.BidWithdrawalOut
 		err error
 	}
 	type tenderRes struct {
 		tender models.Tender
 		err    error
 	}
-	type permissionRes struct {
+	type updateRes struct {
 		err error
 	}
-	type insertReviewRes struct {
-		id  uuid.UUID
-		err error
+	type resubmitRes struct {
+		withdrawal models.Withdrawal
+		err        error
+	}
+	withdrawnAt := time.Unix(10000, 0)
+	resubmittedAt := time.Unix(20000, 0)
+	bidBase := models.BidBase{
+		TenderId:   TENDER_UUID,
+		AuthorType: models.Organization,
+		AuthorId:   ORG_UUID,
 	}
 	tests := []struct {
-		name            string
-		args            args
-		validateRes     *validateRes
-		bidRes          *bidRes
-		tenderRes       *tenderRes
-		permissionRes   *permissionRes
-		insertReviewRes *insertReviewRes
-		want            want
+		name          string
+		args          args
+		bid           models.Bid
+		keepDecisions bool
+		tenderRes     *tenderRes
+		updateRes     *updateRes
+		resubmitRes   *resubmitRes
+		want          want
 	}{
 		{
-			name:        "main line org",
-			args:        args{username: "user", bidId: BID_UUID, feedback: "feedback"},
-			validateRes: &validateRes{nil},
-			bidRes: &bidRes{models.Bid{
-				Id:        BID_UUID,
-				Version:   3,
-				CreatedAt: time.Unix(34, 0),
-				BidBase: models.BidBase{
-					AuthorType: models.Organization,
-					AuthorId:   AUTH_UUID,
-					TenderId:   TENDER_UUID,
-				},
+			name:      "withdrawn",
+			args:      args{context.Background(), "user", BID_UUID},
+			bid:       models.Bid{Id: BID_UUID, Version: 2, Status: models.BidWithdrawn, BidBase: bidBase},
+			tenderRes: &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished}, nil},
+			updateRes: &updateRes{nil},
+			resubmitRes: &resubmitRes{models.Withdrawal{
+				Id:            BID_UUID2,
+				BidId:         BID_UUID,
+				Reason:        "price changed",
+				WithdrawnAt:   withdrawnAt,
+				ResubmittedAt: &resubmittedAt,
 			}, nil},
-			tenderRes: &tenderRes{models.Tender{
-				Version:   7,
-				CreatedAt: time.Unix(10, 0),
-				Id:        BID_UUID,
-				TenderBase: models.TenderBase{
-					OrgId: ORG_UUID,
+			want: want{models.BidWithdrawalOut{
+				BidOut: models.BidOut{Id: BID_UUID, Version: 3, Status: models.BidPublished, BidBase: bidBase},
+				Withdrawal: &models.WithdrawalOut{
+					Id:            BID_UUID2,
+					Reason:        "price changed",
+					WithdrawnAt:   withdrawnAt,
+					ResubmittedAt: &resubmittedAt,
 				},
 			}, nil},
-			permissionRes:   &permissionRes{nil},
-			insertReviewRes: &insertReviewRes{REVIEW_UUID, nil},
-			want: want{models.BidOut{
-				Id:        BID_UUID,
-				Version:   3,
-				CreatedAt: time.Unix(34, 0),
-				BidBase: models.BidBase{
-					AuthorId:   AUTH_UUID,
-					AuthorType: models.Organization,
-					TenderId:   TENDER_UUID,
-				},
+		},
+		{
+			name:          "canceled keeping decisions",
+			args:          args{context.Background(), "user", BID_UUID},
+			bid:           models.Bid{Id: BID_UUID, Version: 1, Status: models.BidCanceled, BidBase: bidBase},
+			keepDecisions: true,
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished}, nil},
+			updateRes:     &updateRes{nil},
+			resubmitRes:   &resubmitRes{models.Withdrawal{}, storage.ErrWithdrawalNotFound},
+			want: want{models.BidWithdrawalOut{
+				BidOut: models.BidOut{Id: BID_UUID, Version: 2, Status: models.BidPublished, BidBase: bidBase},
 			}, nil},
 		},
 		{
-			name:        "bid not found",
-			args:        args{username: "user", bidId: BID_UUID, feedback: "feedback"},
-			validateRes: &validateRes{nil},
-			bidRes:      &bidRes{models.Bid{}, storage.ErrBidNotFound},
-			want:        want{models.BidOut{}, service.ErrBidNotFound},
+			name:      "modified concurrently",
+			args:      args{context.Background(), "user", BID_UUID},
+			bid:       models.Bid{Id: BID_UUID, Version: 2, Status: models.BidWithdrawn, BidBase: bidBase},
+			tenderRes: &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished}, nil},
+			updateRes: &updateRes{storage.ErrVersionConflict},
+			want:      want{models.BidWithdrawalOut{}, service.ErrVersionConflict},
 		},
 		{
-			name:        "tender not found",
-			args:        args{username: "user", bidId: BID_UUID, feedback: "feedback"},
-			validateRes: &validateRes{nil},
-			bidRes:      &bidRes{models.Bid{}, nil},
-			tenderRes:   &tenderRes{models.Tender{}, service.ErrTenderNotFound},
-			want:        want{models.BidOut{}, service.ErrTenderNotFound},
+			name: "published",
+			args: args{context.Background(), "user", BID_UUID},
+			bid:  models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: bidBase},
+			want: want{models.BidWithdrawalOut{}, service.ErrInvalidTransition},
 		},
 		{
-			name:          "user without permissions",
-			args:          args{username: "user", bidId: BID_UUID, feedback: "feedback"},
-			validateRes:   &validateRes{nil},
-			bidRes:        &bidRes{models.Bid{}, nil},
-			tenderRes:     &tenderRes{models.Tender{}, nil},
-			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
-			want:          want{models.BidOut{}, service.ErrNotEnoughPrivileges},
+			name:      "tender closed",
+			args:      args{context.Background(), "user", BID_UUID},
+			bid:       models.Bid{Id: BID_UUID, Status: models.BidWithdrawn, BidBase: bidBase},
+			tenderRes: &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderClosed}, nil},
+			want:      want{models.BidWithdrawalOut{}, service.ErrTenderNotPublished},
+		},
+		{
+			name: "deadline passed",
+			args: args{context.Background(), "user", BID_UUID},
+			bid:  models.Bid{Id: BID_UUID, Status: models.BidWithdrawn, BidBase: bidBase},
+			tenderRes: &tenderRes{models.Tender{
+				Id:         TENDER_UUID,
+				Status:     models.TenderPublished,
+				TenderBase: models.TenderBase{Deadline: ptr.Ptr(time.Now().Add(-time.Hour))},
+			}, nil},
+			want: want{models.BidWithdrawalOut{}, service.ErrDeadlinePassed},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
 			bStorage := mocks.NewBidStorage(t)
-			tender := mocks.NewTenderService(t)
+			rollbackSrv := mocks.NewRollbackService(t)
 
 			bStorage.
 				On("Begin", tt.args.ctx).
 				Return(tt.args.ctx, nil)
-			if tt.validateRes != nil {
-				user.
-					On("Validate", tt.args.ctx, tt.args.username).
-					Return(tt.validateRes.err)
-			}
-			if tt.bidRes != nil {
-				bStorage.
-					On("Bid", tt.args.ctx, tt.args.bidId).
-					Return(tt.bidRes.bid, tt.bidRes.err)
-			}
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			bStorage.
+				On("Bid", tt.args.ctx, tt.args.id).
+				Return(tt.bid, nil)
+			user.
+				On("UserId", tt.args.ctx, tt.args.username).
+				Return(AUTH_UUID, nil)
+			user.
+				On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionPublish).
+				Return(nil)
 			if tt.tenderRes != nil {
-				tender.
-					On("Tender", tt.args.ctx, tt.bidRes.bid.TenderId).
+				tenderSrv.
+					On("Tender", tt.args.ctx, TENDER_UUID).
 					Return(tt.tenderRes.tender, tt.tenderRes.err)
 			}
-			if tt.permissionRes != nil {
-				user.
-					On("Permission", tt.args.ctx, tt.args.username, tt.tenderRes.tender.OrgId).
-					Return(tt.permissionRes.err)
-			}
-			if tt.insertReviewRes != nil {
-				review := models.Review{
-					BidId: tt.bidRes.bid.Id,
-					ReviewBase: models.ReviewBase{
-						Desc: tt.args.feedback,
-					},
-					AuthorName: tt.args.username,
-				}
-
+			if tt.updateRes != nil {
+				newBid := tt.bid
+				newBid.Status = models.BidPublished
+				newBid.Version += 1
 				bStorage.
-					On("InsertReview", tt.args.ctx, review).
-					Return(tt.insertReviewRes.id, tt.insertReviewRes.err)
-
-				if tt.insertReviewRes.err == nil {
+					On("UpdateBid", tt.args.ctx, newBid, tt.bid.Version).
+					Return(tt.updateRes.err)
+				if tt.updateRes.err == nil {
+					rollbackSrv.
+						On("SaveBid", tt.args.ctx, tt.bid).
+						Return(nil)
+				}
+				if tt.updateRes.err == nil && tt.keepDecisions {
 					bStorage.
-						On("Commit", tt.args.ctx).
+						On("CarryOverDecisions", tt.args.ctx, tt.args.id, tt.bid.Version, newBid.Version).
 						Return(nil)
 				}
 			}
+			if tt.resubmitRes != nil {
+				bStorage.
+					On("ResubmitWithdrawal", tt.args.ctx, tt.args.id).
+					Return(tt.resubmitRes.withdrawal, tt.resubmitRes.err)
+				bStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
 			bStorage.
 				On("Rollback", tt.args.ctx).
 				Return(nil)
@@ -1066,17 +2870,20 @@ func TestFeedback(t *testing.T) {
 			bid := Bid{
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
-				userSrv:    user,
-				bidStorage: bStorage,
-				tenderSrv:  tender,
+				userSrv:             user,
+				tenderSrv:           tenderSrv,
+				bidStorage:          bStorage,
+				rollbackSrv:         rollbackSrv,
+				resubmitTransitions: models.NewBidResubmitTransitions(),
+				keepDecisions:       tt.keepDecisions,
 			}
 
-			res, err := bid.Feedback(tt.args.ctx, tt.args.username, tt.args.bidId, tt.args.feedback)
+			res, err := bid.Resubmit(tt.args.ctx, tt.args.id)
+			assert.Equal(t, tt.want.bid, res)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
-				assert.Equal(t, tt.want.bid, res)
 			} else {
-				assert.EqualError(t, err, tt.want.err.Error())
+				assert.ErrorIs(t, err, tt.want.err)
 			}
 		})
 	}
@@ -102,6 +102,24 @@ func (_m *BidStorage) BidSetStatus(ctx context.Context, bidId uuid.UUID, status
 	return r0, r1
 }
 
+// CarryOverDecisions provides a mock function with given fields: ctx, bidId, from, to
+func (_m *BidStorage) CarryOverDecisions(ctx context.Context, bidId uuid.UUID, from int32, to int32) error {
+	ret := _m.Called(ctx, bidId, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CarryOverDecisions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) error); ok {
+		r0 = rf(ctx, bidId, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Commit provides a mock function with given fields: ctx
 func (_m *BidStorage) Commit(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -120,9 +138,48 @@ func (_m *BidStorage) Commit(ctx context.Context) error {
 	return r0
 }
 
-// Decisions provides a mock function with given fields: ctx, bidId
-func (_m *BidStorage) Decisions(ctx context.Context, bidId uuid.UUID) ([]models.Decision, error) {
-	ret := _m.Called(ctx, bidId)
+// DecisionLog provides a mock function with given fields: ctx, bidId, limit, offset, after
+func (_m *BidStorage) DecisionLog(ctx context.Context, bidId uuid.UUID, limit int32, offset int32, after *models.Cursor) ([]models.Decision, *models.Cursor, error) {
+	ret := _m.Called(ctx, bidId, limit, offset, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecisionLog")
+	}
+
+	var r0 []models.Decision
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32, *models.Cursor) ([]models.Decision, *models.Cursor, error)); ok {
+		return rf(ctx, bidId, limit, offset, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32, *models.Cursor) []models.Decision); ok {
+		r0 = rf(ctx, bidId, limit, offset, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Decision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32, *models.Cursor) *models.Cursor); ok {
+		r1 = rf(ctx, bidId, limit, offset, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, int32, int32, *models.Cursor) error); ok {
+		r2 = rf(ctx, bidId, limit, offset, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Decisions provides a mock function with given fields: ctx, bidId, version
+func (_m *BidStorage) Decisions(ctx context.Context, bidId uuid.UUID, version int32) ([]models.Decision, error) {
+	ret := _m.Called(ctx, bidId, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Decisions")
@@ -130,19 +187,19 @@ func (_m *BidStorage) Decisions(ctx context.Context, bidId uuid.UUID) ([]models.
 
 	var r0 []models.Decision
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Decision, error)); ok {
-		return rf(ctx, bidId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) ([]models.Decision, error)); ok {
+		return rf(ctx, bidId, version)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Decision); ok {
-		r0 = rf(ctx, bidId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) []models.Decision); ok {
+		r0 = rf(ctx, bidId, version)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Decision)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, bidId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32) error); ok {
+		r1 = rf(ctx, bidId, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -226,29 +283,85 @@ func (_m *BidStorage) InsertReview(ctx context.Context, review models.Review) (u
 	return r0, r1
 }
 
-// Reviews provides a mock function with given fields: ctx, tenderId, author, limit, offset
-func (_m *BidStorage) Reviews(ctx context.Context, tenderId uuid.UUID, author string, limit int32, offset int32) ([]models.Review, error) {
-	ret := _m.Called(ctx, tenderId, author, limit, offset)
+// InsertWithdrawal provides a mock function with given fields: ctx, withdrawal
+func (_m *BidStorage) InsertWithdrawal(ctx context.Context, withdrawal models.Withdrawal) (models.Withdrawal, error) {
+	ret := _m.Called(ctx, withdrawal)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Reviews")
+		panic("no return value specified for InsertWithdrawal")
 	}
 
-	var r0 []models.Review
+	var r0 models.Withdrawal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Withdrawal) (models.Withdrawal, error)); ok {
+		return rf(ctx, withdrawal)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Withdrawal) models.Withdrawal); ok {
+		r0 = rf(ctx, withdrawal)
+	} else {
+		r0 = ret.Get(0).(models.Withdrawal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Withdrawal) error); ok {
+		r1 = rf(ctx, withdrawal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrgQuorum provides a mock function with given fields: ctx, orgId
+func (_m *BidStorage) OrgQuorum(ctx context.Context, orgId uuid.UUID) (models.Quorum, error) {
+	ret := _m.Called(ctx, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrgQuorum")
+	}
+
+	var r0 models.Quorum
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Quorum, error)); ok {
+		return rf(ctx, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Quorum); ok {
+		r0 = rf(ctx, orgId)
+	} else {
+		r0 = ret.Get(0).(models.Quorum)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishedBidsByPrice provides a mock function with given fields: ctx, tenderId
+func (_m *BidStorage) PublishedBidsByPrice(ctx context.Context, tenderId uuid.UUID) ([]models.Bid, error) {
+	ret := _m.Called(ctx, tenderId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishedBidsByPrice")
+	}
+
+	var r0 []models.Bid
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int32, int32) ([]models.Review, error)); ok {
-		return rf(ctx, tenderId, author, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Bid, error)); ok {
+		return rf(ctx, tenderId)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int32, int32) []models.Review); ok {
-		r0 = rf(ctx, tenderId, author, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Bid); ok {
+		r0 = rf(ctx, tenderId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Review)
+			r0 = ret.Get(0).([]models.Bid)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, int32, int32) error); ok {
-		r1 = rf(ctx, tenderId, author, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, tenderId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -256,6 +369,73 @@ func (_m *BidStorage) Reviews(ctx context.Context, tenderId uuid.UUID, author st
 	return r0, r1
 }
 
+// ResubmitWithdrawal provides a mock function with given fields: ctx, bidId
+func (_m *BidStorage) ResubmitWithdrawal(ctx context.Context, bidId uuid.UUID) (models.Withdrawal, error) {
+	ret := _m.Called(ctx, bidId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResubmitWithdrawal")
+	}
+
+	var r0 models.Withdrawal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Withdrawal, error)); ok {
+		return rf(ctx, bidId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Withdrawal); ok {
+		r0 = rf(ctx, bidId)
+	} else {
+		r0 = ret.Get(0).(models.Withdrawal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, bidId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reviews provides a mock function with given fields: ctx, tenderId, author, limit, offset, after
+func (_m *BidStorage) Reviews(ctx context.Context, tenderId uuid.UUID, author string, limit int32, offset int32, after *models.Cursor) ([]models.Review, *models.Cursor, error) {
+	ret := _m.Called(ctx, tenderId, author, limit, offset, after)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reviews")
+	}
+
+	var r0 []models.Review
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int32, int32, *models.Cursor) ([]models.Review, *models.Cursor, error)); ok {
+		return rf(ctx, tenderId, author, limit, offset, after)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, int32, int32, *models.Cursor) []models.Review); ok {
+		r0 = rf(ctx, tenderId, author, limit, offset, after)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Review)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, int32, int32, *models.Cursor) *models.Cursor); ok {
+		r1 = rf(ctx, tenderId, author, limit, offset, after)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, int32, int32, *models.Cursor) error); ok {
+		r2 = rf(ctx, tenderId, author, limit, offset, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Rollback provides a mock function with given fields: ctx
 func (_m *BidStorage) Rollback(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -274,47 +454,74 @@ func (_m *BidStorage) Rollback(ctx context.Context) error {
 	return r0
 }
 
-// TenderBids provides a mock function with given fields: ctx, tenderId, limit, offset
-func (_m *BidStorage) TenderBids(ctx context.Context, tenderId uuid.UUID, limit int32, offset int32) ([]models.Bid, error) {
-	ret := _m.Called(ctx, tenderId, limit, offset)
+// TenderBids provides a mock function with given fields: ctx, tenderId, viewer, query, limit, offset, after
+func (_m *BidStorage) TenderBids(ctx context.Context, tenderId uuid.UUID, viewer string, query string, limit int32, offset int32, after *models.Cursor) ([]models.Bid, *models.Cursor, error) {
+	ret := _m.Called(ctx, tenderId, viewer, query, limit, offset, after)
 
 	if len(ret) == 0 {
 		panic("no return value specified for TenderBids")
 	}
 
 	var r0 []models.Bid
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) ([]models.Bid, error)); ok {
-		return rf(ctx, tenderId, limit, offset)
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, int32, int32, *models.Cursor) ([]models.Bid, *models.Cursor, error)); ok {
+		return rf(ctx, tenderId, viewer, query, limit, offset, after)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) []models.Bid); ok {
-		r0 = rf(ctx, tenderId, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, int32, int32, *models.Cursor) []models.Bid); ok {
+		r0 = rf(ctx, tenderId, viewer, query, limit, offset, after)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Bid)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32) error); ok {
-		r1 = rf(ctx, tenderId, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, int32, int32, *models.Cursor) *models.Cursor); ok {
+		r1 = rf(ctx, tenderId, viewer, query, limit, offset, after)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, string, string, int32, int32, *models.Cursor) error); ok {
+		r2 = rf(ctx, tenderId, viewer, query, limit, offset, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// UpdateBid provides a mock function with given fields: ctx, _a1
-func (_m *BidStorage) UpdateBid(ctx context.Context, _a1 models.Bid) error {
-	ret := _m.Called(ctx, _a1)
+// TenderBidsSetStatus provides a mock function with given fields: ctx, tenderId, exceptBidId, status
+func (_m *BidStorage) TenderBidsSetStatus(ctx context.Context, tenderId uuid.UUID, exceptBidId uuid.UUID, status models.BidStatus) error {
+	ret := _m.Called(ctx, tenderId, exceptBidId, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenderBidsSetStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, models.BidStatus) error); ok {
+		r0 = rf(ctx, tenderId, exceptBidId, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateBid provides a mock function with given fields: ctx, _a1, version
+func (_m *BidStorage) UpdateBid(ctx context.Context, _a1 models.Bid, version int32) error {
+	ret := _m.Called(ctx, _a1, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateBid")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Bid) error); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Bid, int32) error); ok {
+		r0 = rf(ctx, _a1, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -322,34 +529,43 @@ func (_m *BidStorage) UpdateBid(ctx context.Context, _a1 models.Bid) error {
 	return r0
 }
 
-// UserBids provides a mock function with given fields: ctx, username, limit, offset
-func (_m *BidStorage) UserBids(ctx context.Context, username string, limit int32, offset int32) ([]models.Bid, error) {
-	ret := _m.Called(ctx, username, limit, offset)
+// UserBids provides a mock function with given fields: ctx, username, limit, offset, after
+func (_m *BidStorage) UserBids(ctx context.Context, username string, limit int32, offset int32, after *models.Cursor) ([]models.Bid, *models.Cursor, error) {
+	ret := _m.Called(ctx, username, limit, offset, after)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UserBids")
 	}
 
 	var r0 []models.Bid
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) ([]models.Bid, error)); ok {
-		return rf(ctx, username, limit, offset)
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32, *models.Cursor) ([]models.Bid, *models.Cursor, error)); ok {
+		return rf(ctx, username, limit, offset, after)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32) []models.Bid); ok {
-		r0 = rf(ctx, username, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32, int32, *models.Cursor) []models.Bid); ok {
+		r0 = rf(ctx, username, limit, offset, after)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Bid)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32) error); ok {
-		r1 = rf(ctx, username, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32, int32, *models.Cursor) *models.Cursor); ok {
+		r1 = rf(ctx, username, limit, offset, after)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, int32, int32, *models.Cursor) error); ok {
+		r2 = rf(ctx, username, limit, offset, after)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // NewBidStorage creates a new instance of BidStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
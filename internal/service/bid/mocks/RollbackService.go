@@ -16,6 +16,64 @@ type RollbackService struct {
 	mock.Mock
 }
 
+// BidVersion provides a mock function with given fields: ctx, bidId, version
+func (_m *RollbackService) BidVersion(ctx context.Context, bidId uuid.UUID, version int32) (models.Bid, error) {
+	ret := _m.Called(ctx, bidId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidVersion")
+	}
+
+	var r0 models.Bid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) (models.Bid, error)); ok {
+		return rf(ctx, bidId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) models.Bid); ok {
+		r0 = rf(ctx, bidId, version)
+	} else {
+		r0 = ret.Get(0).(models.Bid)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32) error); ok {
+		r1 = rf(ctx, bidId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BidVersions provides a mock function with given fields: ctx, bidId, limit, offset
+func (_m *RollbackService) BidVersions(ctx context.Context, bidId uuid.UUID, limit int32, offset int32) ([]models.Bid, error) {
+	ret := _m.Called(ctx, bidId, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidVersions")
+	}
+
+	var r0 []models.Bid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) ([]models.Bid, error)); ok {
+		return rf(ctx, bidId, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) []models.Bid); ok {
+		r0 = rf(ctx, bidId, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Bid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32) error); ok {
+		r1 = rf(ctx, bidId, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveBid provides a mock function with given fields: ctx, _a1
 func (_m *RollbackService) SaveBid(ctx context.Context, _a1 models.Bid) error {
 	ret := _m.Called(ctx, _a1)
@@ -16,6 +16,34 @@ type TenderService struct {
 	mock.Mock
 }
 
+// Close provides a mock function with given fields: ctx, id
+func (_m *TenderService) Close(ctx context.Context, id uuid.UUID) (models.Tender, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 models.Tender
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.Tender, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.Tender); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Tender)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Tender provides a mock function with given fields: ctx, id
 func (_m *TenderService) Tender(ctx context.Context, id uuid.UUID) (models.Tender, error) {
 	ret := _m.Called(ctx, id)
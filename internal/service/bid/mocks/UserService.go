@@ -4,9 +4,11 @@ package mocks
 
 import (
 	context "context"
+	models "tender/internal/models"
 
-	uuid "github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // UserService is an autogenerated mock type for the UserService type
@@ -14,6 +16,34 @@ type UserService struct {
 	mock.Mock
 }
 
+// Caller provides a mock function with given fields: ctx
+func (_m *UserService) Caller(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Caller")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // OrgSize provides a mock function with given fields: ctx, orgId
 func (_m *UserService) OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error) {
 	ret := _m.Called(ctx, orgId)
@@ -42,17 +72,17 @@ func (_m *UserService) OrgSize(ctx context.Context, orgId uuid.UUID) (int64, err
 	return r0, r1
 }
 
-// Permission provides a mock function with given fields: ctx, username, orgId
-func (_m *UserService) Permission(ctx context.Context, username string, orgId uuid.UUID) error {
-	ret := _m.Called(ctx, username, orgId)
+// Permission provides a mock function with given fields: ctx, username, orgId, action
+func (_m *UserService) Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error {
+	ret := _m.Called(ctx, username, orgId, action)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Permission")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
-		r0 = rf(ctx, username, orgId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, models.Action) error); ok {
+		r0 = rf(ctx, username, orgId, action)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -0,0 +1,345 @@
+package employee
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"tender/internal/lib/logger/sl"
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+type Employee struct {
+	log             *slog.Logger
+	employeeStorage EmployeeStorage
+	userSrv         UserService
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name UserService
+type UserService interface {
+	Caller(ctx context.Context) (string, error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name EmployeeStorage
+type EmployeeStorage interface {
+	Begin(ctx context.Context) (context.Context, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+
+	InsertEmployee(ctx context.Context, employee models.Employee) (models.Employee, error)
+	Employee(ctx context.Context, id uuid.UUID) (models.Employee, error)
+	UpdateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error)
+	DeleteEmployee(ctx context.Context, id uuid.UUID) error
+	LastOrgAdmin(ctx context.Context, id uuid.UUID) (bool, error)
+	Employees(ctx context.Context, limit, offset int32) ([]models.Employee, error)
+}
+
+func New(
+	log *slog.Logger,
+	userSrv UserService,
+	employeeStorage EmployeeStorage,
+) *Employee {
+	return &Employee{
+		log:             log,
+		employeeStorage: employeeStorage,
+		userSrv:         userSrv,
+	}
+}
+
+// New registers new employee.
+// Only existing employees can register new ones.
+func (e *Employee) New(ctx context.Context, employeeNew models.EmployeeNew) (models.EmployeeOut, error) {
+	const op = "Employee.New"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("new username", employeeNew.Username),
+	)
+
+	ctx, err := e.employeeStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.employeeStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.EmployeeOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Insert employee.
+	employee, err := e.employeeStorage.InsertEmployee(ctx, employeeNew.ToEmployee())
+	if err != nil {
+		if errors.Is(err, storage.ErrUsernameTaken) {
+			log.Warn("username is taken")
+			return models.EmployeeOut{}, service.ErrUsernameTaken
+		}
+		log.Error("failed to insert employee", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.employeeStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return employee.ToOut(), nil
+}
+
+// Get returns employee.
+func (e *Employee) Get(ctx context.Context, employeeId uuid.UUID) (models.EmployeeOut, error) {
+	const op = "Employee.Get"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("employee id", employeeId.String()),
+	)
+
+	ctx, err := e.employeeStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.employeeStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.EmployeeOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get employee.
+	employee, err := e.employeeStorage.Employee(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, storage.ErrEmployeeNotFound) {
+			log.Warn("employee not found")
+			return models.EmployeeOut{}, service.ErrEmployeeNotFound
+		}
+		log.Error("failed to get employee", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.employeeStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return employee.ToOut(), nil
+}
+
+// List returns employees.
+func (e *Employee) List(ctx context.Context, limit, offset int32) ([]models.EmployeeOut, error) {
+	const op = "Employee.List"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	ctx, err := e.employeeStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.employeeStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get employees.
+	res, err := e.employeeStorage.Employees(ctx, limit, offset)
+	if err != nil {
+		log.Error("failed to get employees", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	out := make([]models.EmployeeOut, 0, len(res))
+	for i := range res {
+		out = append(out, res[i].ToOut())
+	}
+
+	if err := e.employeeStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, nil
+}
+
+// Edit patches employee's profile.
+// Employees can only edit themselves.
+func (e *Employee) Edit(ctx context.Context, employeeId uuid.UUID, patch models.EmployeePatch) (models.EmployeeOut, error) {
+	const op = "Employee.Edit"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("employee id", employeeId.String()),
+	)
+
+	ctx, err := e.employeeStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.employeeStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get employee and check it is the user.
+	employee, err := e.self(ctx, log, employeeId)
+	if err != nil {
+		return models.EmployeeOut{}, err
+	}
+
+	// Apply patch.
+	employee.Patch(patch)
+
+	// Update employee.
+	employee, err = e.employeeStorage.UpdateEmployee(ctx, employee)
+	if err != nil {
+		log.Error("failed to update employee", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.employeeStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.EmployeeOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return employee.ToOut(), nil
+}
+
+// Delete deletes employee with its memberships.
+// Employees can only delete themselves and
+// the last admin of organization can't be deleted.
+func (e *Employee) Delete(ctx context.Context, employeeId uuid.UUID) error {
+	const op = "Employee.Delete"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("employee id", employeeId.String()),
+	)
+
+	ctx, err := e.employeeStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.employeeStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get employee and check it is the user.
+	if _, err := e.self(ctx, log, employeeId); err != nil {
+		return err
+	}
+
+	// Check if no organization is left without admin.
+	last, err := e.employeeStorage.LastOrgAdmin(ctx, employeeId)
+	if err != nil {
+		log.Error("failed to check org admins", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if last {
+		log.Warn("employee is the last admin of organization")
+		return service.ErrLastAdmin
+	}
+
+	// Delete employee.
+	if err := e.employeeStorage.DeleteEmployee(ctx, employeeId); err != nil {
+		if errors.Is(err, storage.ErrEmployeeNotFound) {
+			log.Warn("employee not found")
+			return service.ErrEmployeeNotFound
+		}
+		log.Error("failed to delete employee", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.employeeStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// self returns employee if it is the user.
+func (e *Employee) self(ctx context.Context, log *slog.Logger, employeeId uuid.UUID) (models.Employee, error) {
+	const op = "Employee.self"
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.Employee{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get employee.
+	employee, err := e.employeeStorage.Employee(ctx, employeeId)
+	if err != nil {
+		if errors.Is(err, storage.ErrEmployeeNotFound) {
+			log.Warn("employee not found")
+			return models.Employee{}, service.ErrEmployeeNotFound
+		}
+		log.Error("failed to get employee", sl.Err(err))
+		return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if employee is the user.
+	if employee.Username != username {
+		log.Warn("unallowed to change other employee")
+		return models.Employee{}, service.ErrNotEnoughPrivileges
+	}
+
+	return employee, nil
+}
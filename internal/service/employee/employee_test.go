@@ -0,0 +1,235 @@
+package employee
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	ptr "tender/internal/lib/utils/pointers"
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/service/employee/mocks"
+	"tender/internal/storage"
+)
+
+var ID_UUID = uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd")
+
+func TestEdit(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		username   string
+		employeeId uuid.UUID
+		patch      models.EmployeePatch
+	}
+	type want struct {
+		employee models.EmployeeOut
+		err      error
+	}
+	type employeeRes struct {
+		employee models.Employee
+		err      error
+	}
+	type updateRes struct {
+		err error
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        want
+		employeeRes *employeeRes
+		updateRes   *updateRes
+	}{
+		{
+			name: "main line",
+			args: args{context.Background(), "user", ID_UUID, models.EmployeePatch{
+				FirstName: ptr.Ptr("Ivan"),
+			}},
+			want: want{models.EmployeeOut{
+				EmployeeBase: models.EmployeeBase{Username: "user", FirstName: "Ivan", LastName: "Ivanov"},
+				Id:           ID_UUID,
+			}, nil},
+			employeeRes: &employeeRes{models.Employee{
+				EmployeeBase: models.EmployeeBase{Username: "user", LastName: "Ivanov"},
+				Id:           ID_UUID,
+			}, nil},
+			updateRes: &updateRes{nil},
+		},
+		{
+			name:        "employee not found",
+			args:        args{context.Background(), "user", ID_UUID, models.EmployeePatch{}},
+			want:        want{models.EmployeeOut{}, service.ErrEmployeeNotFound},
+			employeeRes: &employeeRes{models.Employee{}, storage.ErrEmployeeNotFound},
+		},
+		{
+			name: "other employee",
+			args: args{context.Background(), "intruder", ID_UUID, models.EmployeePatch{}},
+			want: want{models.EmployeeOut{}, service.ErrNotEnoughPrivileges},
+			employeeRes: &employeeRes{models.Employee{
+				EmployeeBase: models.EmployeeBase{Username: "user"},
+				Id:           ID_UUID,
+			}, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			eStorage := mocks.NewEmployeeStorage(t)
+
+			eStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.employeeRes != nil {
+				eStorage.
+					On("Employee", mock.Anything, tt.args.employeeId).
+					Return(tt.employeeRes.employee, tt.employeeRes.err)
+			}
+			if tt.updateRes != nil {
+				eStorage.
+					On("UpdateEmployee", mock.Anything, mock.Anything).
+					Return(func(_ context.Context, e models.Employee) (models.Employee, error) {
+						return e, tt.updateRes.err
+					})
+				if tt.updateRes.err == nil {
+					eStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			eStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			employee := Employee{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:         user,
+				employeeStorage: eStorage,
+			}
+
+			res, err := employee.Edit(tt.args.ctx, tt.args.employeeId, tt.args.patch)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.employee, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		username   string
+		employeeId uuid.UUID
+	}
+	type employeeRes struct {
+		employee models.Employee
+		err      error
+	}
+	type lastAdminRes struct {
+		last bool
+		err  error
+	}
+	type deleteRes struct {
+		err error
+	}
+	tests := []struct {
+		name         string
+		args         args
+		employeeRes  *employeeRes
+		lastAdminRes *lastAdminRes
+		deleteRes    *deleteRes
+		wantErr      error
+	}{
+		{
+			name: "main line",
+			args: args{context.Background(), "user", ID_UUID},
+			employeeRes: &employeeRes{models.Employee{
+				EmployeeBase: models.EmployeeBase{Username: "user"},
+				Id:           ID_UUID,
+			}, nil},
+			lastAdminRes: &lastAdminRes{false, nil},
+			deleteRes:    &deleteRes{nil},
+		},
+		{
+			name: "last org admin",
+			args: args{context.Background(), "user", ID_UUID},
+			employeeRes: &employeeRes{models.Employee{
+				EmployeeBase: models.EmployeeBase{Username: "user"},
+				Id:           ID_UUID,
+			}, nil},
+			lastAdminRes: &lastAdminRes{true, nil},
+			wantErr:      service.ErrLastAdmin,
+		},
+		{
+			name: "other employee",
+			args: args{context.Background(), "intruder", ID_UUID},
+			employeeRes: &employeeRes{models.Employee{
+				EmployeeBase: models.EmployeeBase{Username: "user"},
+				Id:           ID_UUID,
+			}, nil},
+			wantErr: service.ErrNotEnoughPrivileges,
+		},
+		{
+			name:        "employee not found",
+			args:        args{context.Background(), "user", ID_UUID},
+			employeeRes: &employeeRes{models.Employee{}, storage.ErrEmployeeNotFound},
+			wantErr:     service.ErrEmployeeNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			eStorage := mocks.NewEmployeeStorage(t)
+
+			eStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.employeeRes != nil {
+				eStorage.
+					On("Employee", tt.args.ctx, tt.args.employeeId).
+					Return(tt.employeeRes.employee, tt.employeeRes.err)
+			}
+			if tt.lastAdminRes != nil {
+				eStorage.
+					On("LastOrgAdmin", tt.args.ctx, tt.args.employeeId).
+					Return(tt.lastAdminRes.last, tt.lastAdminRes.err)
+			}
+			if tt.deleteRes != nil {
+				eStorage.
+					On("DeleteEmployee", tt.args.ctx, tt.args.employeeId).
+					Return(tt.deleteRes.err)
+				if tt.deleteRes.err == nil {
+					eStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			eStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			employee := Employee{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:         user,
+				employeeStorage: eStorage,
+			}
+
+			err := employee.Delete(tt.args.ctx, tt.args.employeeId)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
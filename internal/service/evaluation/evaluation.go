@@ -0,0 +1,423 @@
+package evaluation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"tender/internal/lib/logger/sl"
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+type Evaluation struct {
+	log         *slog.Logger
+	userSrv     UserService
+	tenderSrv   TenderService
+	evalStorage EvaluationStorage
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name UserService
+type UserService interface {
+	Caller(ctx context.Context) (string, error)
+	UserId(ctx context.Context, username string) (uuid.UUID, error)
+	Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name TenderService
+type TenderService interface {
+	Tender(ctx context.Context, id uuid.UUID) (models.Tender, error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name EvaluationStorage
+type EvaluationStorage interface {
+	Begin(ctx context.Context) (context.Context, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+
+	Bid(ctx context.Context, bidId uuid.UUID) (models.Bid, error)
+	SubmittedBidsByPrice(ctx context.Context, tenderId uuid.UUID) ([]models.Bid, error)
+
+	ReplaceCriteria(ctx context.Context, tenderId uuid.UUID, criteria []models.Criterion) ([]models.Criterion, error)
+	Criteria(ctx context.Context, tenderId uuid.UUID) ([]models.Criterion, error)
+	InsertScores(ctx context.Context, scores []models.Score) error
+	BidScores(ctx context.Context, tenderId uuid.UUID) ([]models.CriterionScore, error)
+}
+
+func New(
+	log *slog.Logger,
+	userSrv UserService,
+	tenderSrv TenderService,
+	evalStorage EvaluationStorage,
+) *Evaluation {
+	return &Evaluation{
+		log:         log,
+		userSrv:     userSrv,
+		tenderSrv:   tenderSrv,
+		evalStorage: evalStorage,
+	}
+}
+
+// SetCriteria replaces tender's evaluation criteria.
+// Scores given by previous criteria are dropped.
+func (e *Evaluation) SetCriteria(ctx context.Context, tenderId uuid.UUID, criteriaNew models.CriteriaNew) ([]models.CriterionOut, error) {
+	const op = "Evaluation.SetCriteria"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("tender id", tenderId.String()),
+	)
+
+	ctx, err := e.evalStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.evalStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := e.tender(ctx, log, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if user is allowed to modify tender info.
+	if err := e.userSrv.Permission(ctx, username, tender.OrgId, models.ActionEdit); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("user not allowed")
+			return nil, err
+		}
+		log.Error("failed to check permission", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Replace criteria.
+	criteria, err := e.evalStorage.ReplaceCriteria(ctx, tenderId, criteriaNew.ToCriteria(tenderId))
+	if err != nil {
+		log.Error("failed to replace criteria", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.evalStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	res := make([]models.CriterionOut, 0, len(criteria))
+	for _, c := range criteria {
+		res = append(res, c.ToOut())
+	}
+
+	return res, nil
+}
+
+// Criteria returns tender's evaluation criteria.
+// Criteria of unpublished tender are visible only to responsibles.
+func (e *Evaluation) Criteria(ctx context.Context, tenderId uuid.UUID) ([]models.CriterionOut, error) {
+	const op = "Evaluation.Criteria"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("tender id", tenderId.String()),
+	)
+
+	ctx, err := e.evalStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.evalStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := e.tender(ctx, log, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drafts and closed tenders are visible only to responsibles.
+	if tender.Status != models.TenderPublished {
+		if err := e.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				log.Warn("unallowed to view", slog.String("status", string(tender.Status)))
+				return nil, err
+			}
+			log.Error("failed to check permission", sl.Err(err))
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Get criteria.
+	criteria, err := e.evalStorage.Criteria(ctx, tenderId)
+	if err != nil {
+		log.Error("failed to get criteria", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.evalStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	res := make([]models.CriterionOut, 0, len(criteria))
+	for _, c := range criteria {
+		res = append(res, c.ToOut())
+	}
+
+	return res, nil
+}
+
+// Score saves user's per-criterion scores of published bid.
+// Scores given earlier by the same user are overwritten.
+// Bids can be scored only while tender is published
+// and not by their authors.
+func (e *Evaluation) Score(ctx context.Context, bidId uuid.UUID, scoresNew models.ScoresNew) error {
+	const op = "Evaluation.Score"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("bid id", bidId.String()),
+	)
+
+	ctx, err := e.evalStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.evalStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get bid.
+	bid, err := e.evalStorage.Bid(ctx, bidId)
+	if err != nil {
+		if errors.Is(err, storage.ErrBidNotFound) {
+			log.Warn("bid not found")
+			return service.ErrBidNotFound
+		}
+		log.Error("failed to get bid", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get bid's tender.
+	tender, err := e.tender(ctx, log, bid.TenderId)
+	if err != nil {
+		return err
+	}
+
+	// Only tender's approvers score bids.
+	if err := e.userSrv.Permission(ctx, username, tender.OrgId, models.ActionApprove); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("user not allowed")
+			return err
+		}
+		log.Error("failed to check permission", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Scores can't be changed after tender is closed.
+	if tender.Status != models.TenderPublished {
+		log.Warn("tender is not published", slog.String("tender status", string(tender.Status)))
+		return service.ErrTenderNotPublished
+	}
+
+	if bid.Status != models.BidPublished {
+		log.Warn("bid is not published", slog.String("status", string(bid.Status)))
+		return service.ErrBidNotPublished
+	}
+
+	// Get user id.
+	userId, err := e.userSrv.UserId(ctx, username)
+	if err != nil {
+		log.Error("failed to get user id", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user is not scoring own bid.
+	if err := service.OwnBid(ctx, e.userSrv, username, userId, bid); err != nil {
+		if errors.Is(err, service.ErrConflictOfInterest) {
+			log.Warn("user is author of bid")
+			return err
+		}
+		log.Error("failed to check bid's author", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if scores refer to tender's criteria.
+	criteria, err := e.evalStorage.Criteria(ctx, tender.Id)
+	if err != nil {
+		log.Error("failed to get criteria", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	known := make(map[uuid.UUID]struct{}, len(criteria))
+	for _, c := range criteria {
+		known[c.Id] = struct{}{}
+	}
+	for _, s := range scoresNew.Scores {
+		if _, ok := known[s.CriterionId]; !ok {
+			log.Warn("criterion not found", slog.String("criterion id", s.CriterionId.String()))
+			return service.ErrCriterionNotFound
+		}
+	}
+
+	// Save scores.
+	if err := e.evalStorage.InsertScores(ctx, scoresNew.ToScores(userId, bidId)); err != nil {
+		log.Error("failed to insert scores", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.evalStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Ranking returns tender's published, approved and rejected bids
+// ordered by weighted average of their scores, best first.
+// Drafts, canceled and withdrawn bids are not ranked.
+// Bids with equal score are ordered by price.
+func (e *Evaluation) Ranking(ctx context.Context, tenderId uuid.UUID) ([]models.BidRankOut, error) {
+	const op = "Evaluation.Ranking"
+
+	log := e.log.With(
+		slog.String("op", op),
+		slog.String("tender id", tenderId.String()),
+	)
+
+	ctx, err := e.evalStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := e.evalStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := e.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := e.tender(ctx, log, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ranking is visible only to responsibles.
+	if err := e.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("user not allowed")
+			return nil, err
+		}
+		log.Error("failed to check permission", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get criteria.
+	criteria, err := e.evalStorage.Criteria(ctx, tenderId)
+	if err != nil {
+		log.Error("failed to get criteria", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get bids.
+	bids, err := e.evalStorage.SubmittedBidsByPrice(ctx, tenderId)
+	if err != nil {
+		log.Error("failed to get bids", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get aggregated scores.
+	scores, err := e.evalStorage.BidScores(ctx, tenderId)
+	if err != nil {
+		log.Error("failed to get scores", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := e.evalStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return models.Rank(bids, criteria, scores), nil
+}
+
+// tender returns tender by id.
+func (e *Evaluation) tender(ctx context.Context, log *slog.Logger, tenderId uuid.UUID) (models.Tender, error) {
+	const op = "Evaluation.tender"
+
+	tender, err := e.tenderSrv.Tender(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.Tender{}, err
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tender, nil
+}
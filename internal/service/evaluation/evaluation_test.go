@@ -0,0 +1,515 @@
+package evaluation
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/service/evaluation/mocks"
+	"tender/internal/storage"
+)
+
+var (
+	USER_UUID       = uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd")
+	ORG_UUID        = uuid.MustParse("002f9d2b-cd76-4921-8e53-21dbde75f993")
+	TENDER_UUID     = uuid.MustParse("3fa85f64-5717-4562-b3fc-2c963f66afa6")
+	BID_UUID        = uuid.MustParse("0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c")
+	CRITERION_UUID  = uuid.MustParse("5b3f2a6c-27a5-4c5f-8d7e-6a1f9a1c0b2d")
+	AUTHOR_ORG_UUID = uuid.MustParse("7c9e6679-7425-40de-944b-e07fc1f90ae7")
+)
+
+func TestScore(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		bidId    uuid.UUID
+		scores   models.ScoresNew
+	}
+	type bidRes struct {
+		bid models.Bid
+		err error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type criteriaRes struct {
+		criteria []models.Criterion
+	}
+	type insertRes struct {
+		err error
+	}
+	scores := models.ScoresNew{Scores: []models.ScoreBase{{CriterionId: CRITERION_UUID, Score: 7}}}
+	published := models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}}
+	tests := []struct {
+		name          string
+		args          args
+		want          error
+		bidRes        *bidRes
+		tenderStatus  models.TenderStatus
+		permissionRes *permissionRes
+		userId        bool
+		// Membership in bid author's organization.
+		authorPermissionRes *permissionRes
+		criteriaRes         *criteriaRes
+		insertRes           *insertRes
+	}{
+		{
+			name:          "main line",
+			args:          args{context.Background(), "user", BID_UUID, scores},
+			bidRes:        &bidRes{published, nil},
+			tenderStatus:  models.TenderPublished,
+			permissionRes: &permissionRes{nil},
+			userId:        true,
+			criteriaRes:   &criteriaRes{[]models.Criterion{{Id: CRITERION_UUID, TenderId: TENDER_UUID}}},
+			insertRes:     &insertRes{nil},
+		},
+		{
+			name:   "bid not found",
+			args:   args{context.Background(), "user", BID_UUID, scores},
+			want:   service.ErrBidNotFound,
+			bidRes: &bidRes{models.Bid{}, storage.ErrBidNotFound},
+		},
+		{
+			name:          "not approver",
+			args:          args{context.Background(), "user", BID_UUID, scores},
+			want:          service.ErrNotEnoughPrivileges,
+			bidRes:        &bidRes{published, nil},
+			tenderStatus:  models.TenderPublished,
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionApprove}},
+		},
+		{
+			name:          "tender closed",
+			args:          args{context.Background(), "user", BID_UUID, scores},
+			want:          service.ErrTenderNotPublished,
+			bidRes:        &bidRes{published, nil},
+			tenderStatus:  models.TenderClosed,
+			permissionRes: &permissionRes{nil},
+		},
+		{
+			name:          "bid not published",
+			args:          args{context.Background(), "user", BID_UUID, scores},
+			want:          service.ErrBidNotPublished,
+			bidRes:        &bidRes{models.Bid{Id: BID_UUID, Status: models.BidCreated, BidBase: models.BidBase{TenderId: TENDER_UUID}}, nil},
+			tenderStatus:  models.TenderPublished,
+			permissionRes: &permissionRes{nil},
+		},
+		{
+			name: "own bid",
+			args: args{context.Background(), "user", BID_UUID, scores},
+			want: service.ErrConflictOfInterest,
+			bidRes: &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{
+				TenderId:   TENDER_UUID,
+				AuthorType: models.User,
+				AuthorId:   USER_UUID,
+			}}, nil},
+			tenderStatus:  models.TenderPublished,
+			permissionRes: &permissionRes{nil},
+			userId:        true,
+		},
+		{
+			name: "own organization's bid",
+			args: args{context.Background(), "user", BID_UUID, scores},
+			want: service.ErrConflictOfInterest,
+			bidRes: &bidRes{models.Bid{Id: BID_UUID, Status: models.BidPublished, BidBase: models.BidBase{
+				TenderId:   TENDER_UUID,
+				AuthorType: models.Organization,
+				AuthorId:   AUTHOR_ORG_UUID,
+			}}, nil},
+			tenderStatus:        models.TenderPublished,
+			permissionRes:       &permissionRes{nil},
+			userId:              true,
+			authorPermissionRes: &permissionRes{nil},
+		},
+		{
+			name:          "unknown criterion",
+			args:          args{context.Background(), "user", BID_UUID, scores},
+			want:          service.ErrCriterionNotFound,
+			bidRes:        &bidRes{published, nil},
+			tenderStatus:  models.TenderPublished,
+			permissionRes: &permissionRes{nil},
+			userId:        true,
+			criteriaRes:   &criteriaRes{nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			eStorage := mocks.NewEvaluationStorage(t)
+
+			eStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.bidRes != nil {
+				eStorage.
+					On("Bid", tt.args.ctx, tt.args.bidId).
+					Return(tt.bidRes.bid, tt.bidRes.err)
+			}
+			if tt.permissionRes != nil {
+				tenderSrv.
+					On("Tender", tt.args.ctx, TENDER_UUID).
+					Return(models.Tender{Id: TENDER_UUID, Status: tt.tenderStatus, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil)
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionApprove).
+					Return(tt.permissionRes.err)
+			}
+			if tt.userId {
+				user.
+					On("UserId", tt.args.ctx, tt.args.username).
+					Return(USER_UUID, nil)
+			}
+			if tt.authorPermissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, tt.bidRes.bid.AuthorId, models.ActionView).
+					Return(tt.authorPermissionRes.err)
+			}
+			if tt.criteriaRes != nil {
+				eStorage.
+					On("Criteria", tt.args.ctx, TENDER_UUID).
+					Return(tt.criteriaRes.criteria, nil)
+			}
+			if tt.insertRes != nil {
+				eStorage.
+					On("InsertScores", tt.args.ctx, mock.Anything).
+					Return(tt.insertRes.err)
+				eStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			eStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			evaluation := Evaluation{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:     user,
+				tenderSrv:   tenderSrv,
+				evalStorage: eStorage,
+			}
+
+			err := evaluation.Score(tt.args.ctx, tt.args.bidId, tt.args.scores)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetCriteria(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		tenderId uuid.UUID
+		criteria models.CriteriaNew
+	}
+	type want struct {
+		criteria []models.CriterionOut
+		err      error
+	}
+	type tenderRes struct {
+		err error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type replaceRes struct {
+		criteria []models.Criterion
+	}
+	base := models.CriterionBase{Name: "quality", Type: models.CriterionQuality, Weight: 10}
+	criteria := models.CriteriaNew{Criteria: []models.CriterionBase{base}}
+	tests := []struct {
+		name          string
+		args          args
+		want          want
+		tenderRes     *tenderRes
+		permissionRes *permissionRes
+		replaceRes    *replaceRes
+	}{
+		{
+			name:          "main line",
+			args:          args{context.Background(), "user", TENDER_UUID, criteria},
+			want:          want{[]models.CriterionOut{{CriterionBase: base, Id: CRITERION_UUID}}, nil},
+			tenderRes:     &tenderRes{nil},
+			permissionRes: &permissionRes{nil},
+			replaceRes:    &replaceRes{[]models.Criterion{{CriterionBase: base, Id: CRITERION_UUID, TenderId: TENDER_UUID}}},
+		},
+		{
+			name:      "tender not found",
+			args:      args{context.Background(), "user", TENDER_UUID, criteria},
+			want:      want{nil, service.ErrTenderNotFound},
+			tenderRes: &tenderRes{service.ErrTenderNotFound},
+		},
+		{
+			name:          "no permissions",
+			args:          args{context.Background(), "user", TENDER_UUID, criteria},
+			want:          want{nil, service.ErrNotEnoughPrivileges},
+			tenderRes:     &tenderRes{nil},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionEdit}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			eStorage := mocks.NewEvaluationStorage(t)
+
+			eStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.tenderRes != nil {
+				tenderSrv.
+					On("Tender", tt.args.ctx, tt.args.tenderId).
+					Return(models.Tender{Id: TENDER_UUID, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, tt.tenderRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionEdit).
+					Return(tt.permissionRes.err)
+			}
+			if tt.replaceRes != nil {
+				eStorage.
+					On("ReplaceCriteria", tt.args.ctx, tt.args.tenderId, tt.args.criteria.ToCriteria(tt.args.tenderId)).
+					Return(tt.replaceRes.criteria, nil)
+				eStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			eStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			evaluation := Evaluation{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:     user,
+				tenderSrv:   tenderSrv,
+				evalStorage: eStorage,
+			}
+
+			res, err := evaluation.SetCriteria(tt.args.ctx, tt.args.tenderId, tt.args.criteria)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.criteria, res)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestCriteria(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		tenderId uuid.UUID
+	}
+	type want struct {
+		criteria []models.CriterionOut
+		err      error
+	}
+	type permissionRes struct {
+		err error
+	}
+	base := models.CriterionBase{Name: "quality", Type: models.CriterionQuality, Weight: 10}
+	tests := []struct {
+		name          string
+		args          args
+		want          want
+		status        models.TenderStatus
+		permissionRes *permissionRes
+		criteria      bool
+	}{
+		{
+			name:     "published tender",
+			args:     args{context.Background(), "user", TENDER_UUID},
+			want:     want{[]models.CriterionOut{{CriterionBase: base, Id: CRITERION_UUID}}, nil},
+			status:   models.TenderPublished,
+			criteria: true,
+		},
+		{
+			name:          "draft visible to responsible",
+			args:          args{context.Background(), "user", TENDER_UUID},
+			want:          want{[]models.CriterionOut{{CriterionBase: base, Id: CRITERION_UUID}}, nil},
+			status:        models.TenderCreated,
+			permissionRes: &permissionRes{nil},
+			criteria:      true,
+		},
+		{
+			name:          "draft hidden from others",
+			args:          args{context.Background(), "user", TENDER_UUID},
+			want:          want{nil, service.ErrNotEnoughPrivileges},
+			status:        models.TenderCreated,
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			eStorage := mocks.NewEvaluationStorage(t)
+
+			eStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			tenderSrv.
+				On("Tender", tt.args.ctx, tt.args.tenderId).
+				Return(models.Tender{Id: TENDER_UUID, Status: tt.status, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil)
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.criteria {
+				eStorage.
+					On("Criteria", tt.args.ctx, tt.args.tenderId).
+					Return([]models.Criterion{{CriterionBase: base, Id: CRITERION_UUID, TenderId: TENDER_UUID}}, nil)
+				eStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			eStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			evaluation := Evaluation{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:     user,
+				tenderSrv:   tenderSrv,
+				evalStorage: eStorage,
+			}
+
+			res, err := evaluation.Criteria(tt.args.ctx, tt.args.tenderId)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.criteria, res)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestRanking(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		tenderId uuid.UUID
+	}
+	type permissionRes struct {
+		err error
+	}
+	bid2 := uuid.MustParse("7c9e6679-7425-40de-944b-e07fc1f90ae7")
+	criteria := []models.Criterion{{
+		CriterionBase: models.CriterionBase{Name: "quality", Type: models.CriterionQuality, Weight: 10},
+		Id:            CRITERION_UUID,
+		TenderId:      TENDER_UUID,
+	}}
+	// Approved bid goes first by price but is scored lower.
+	bids := []models.Bid{
+		{Id: BID_UUID, Status: models.BidApproved, BidBase: models.BidBase{TenderId: TENDER_UUID}},
+		{Id: bid2, Status: models.BidPublished, BidBase: models.BidBase{TenderId: TENDER_UUID}},
+	}
+	scores := []models.CriterionScore{
+		{BidId: BID_UUID, CriterionId: CRITERION_UUID, Average: 4, Count: 1},
+		{BidId: bid2, CriterionId: CRITERION_UUID, Average: 8, Count: 2},
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          []uuid.UUID
+		wantErr       error
+		permissionRes *permissionRes
+	}{
+		{
+			name:          "main line",
+			args:          args{context.Background(), "user", TENDER_UUID},
+			want:          []uuid.UUID{bid2, BID_UUID},
+			permissionRes: &permissionRes{nil},
+		},
+		{
+			name:          "no permissions",
+			args:          args{context.Background(), "user", TENDER_UUID},
+			wantErr:       service.ErrNotEnoughPrivileges,
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			eStorage := mocks.NewEvaluationStorage(t)
+
+			eStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			tenderSrv.
+				On("Tender", tt.args.ctx, tt.args.tenderId).
+				Return(models.Tender{Id: TENDER_UUID, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil)
+			user.
+				On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
+				Return(tt.permissionRes.err)
+			if tt.permissionRes.err == nil {
+				eStorage.
+					On("Criteria", tt.args.ctx, tt.args.tenderId).
+					Return(criteria, nil)
+				eStorage.
+					On("SubmittedBidsByPrice", tt.args.ctx, tt.args.tenderId).
+					Return(bids, nil)
+				eStorage.
+					On("BidScores", tt.args.ctx, tt.args.tenderId).
+					Return(scores, nil)
+				eStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			eStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			evaluation := Evaluation{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:     user,
+				tenderSrv:   tenderSrv,
+				evalStorage: eStorage,
+			}
+
+			res, err := evaluation.Ranking(tt.args.ctx, tt.args.tenderId)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			ids := make([]uuid.UUID, 0, len(res))
+			for i := range res {
+				ids = append(ids, res[i].Bid.Id)
+				assert.Equal(t, int32(i+1), res[i].Place)
+			}
+			assert.Equal(t, tt.want, ids)
+			assert.Equal(t, models.BidApproved, res[1].Bid.Status)
+		})
+	}
+}
@@ -0,0 +1,634 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"tender/internal/lib/logger/sl"
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+type Organization struct {
+	log        *slog.Logger
+	orgStorage OrgStorage
+	userSrv    UserService
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name UserService
+type UserService interface {
+	Caller(ctx context.Context) (string, error)
+	Validate(ctx context.Context, username string) error
+	UserId(ctx context.Context, username string) (uuid.UUID, error)
+	Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name OrgStorage
+type OrgStorage interface {
+	Begin(ctx context.Context) (context.Context, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+
+	InsertOrg(ctx context.Context, org models.Org) (models.Org, error)
+	Org(ctx context.Context, id uuid.UUID) (models.Org, error)
+	UpdateOrg(ctx context.Context, org models.Org) (models.Org, error)
+	DeleteOrg(ctx context.Context, id uuid.UUID) error
+	Orgs(ctx context.Context, limit, offset int32) ([]models.Org, error)
+
+	InsertResponsible(ctx context.Context, orgId, userId uuid.UUID, role models.Role) error
+	Responsible(ctx context.Context, orgId, userId uuid.UUID) (models.Responsible, error)
+	UpdateResponsible(ctx context.Context, orgId, userId uuid.UUID, role models.Role) error
+	DeleteResponsible(ctx context.Context, orgId, userId uuid.UUID) error
+	OrgAdmins(ctx context.Context, orgId uuid.UUID) (int64, error)
+	Responsibles(ctx context.Context, orgId uuid.UUID, limit, offset int32) ([]models.Responsible, error)
+}
+
+func New(
+	log *slog.Logger,
+	userSrv UserService,
+	orgStorage OrgStorage,
+) *Organization {
+	return &Organization{
+		log:        log,
+		orgStorage: orgStorage,
+		userSrv:    userSrv,
+	}
+}
+
+// New adds new organization.
+// Creator becomes organization's admin.
+func (o *Organization) New(ctx context.Context, orgNew models.OrgNew) (models.OrgOut, error) {
+	const op = "Organization.New"
+
+	log := o.log.With(
+		slog.String("op", op),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := o.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.OrgOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get creator's id.
+	userId, err := o.userSrv.UserId(ctx, username)
+	if err != nil {
+		log.Error("failed to get user id", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Insert organization.
+	org, err := o.orgStorage.InsertOrg(ctx, orgNew.ToOrg())
+	if err != nil {
+		log.Error("failed to insert organization", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Make creator organization's admin.
+	if err := o.orgStorage.InsertResponsible(ctx, org.Id, userId, models.RoleOrgAdmin); err != nil {
+		log.Error("failed to insert responsible", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org.ToOut(), nil
+}
+
+// Get returns organization.
+func (o *Organization) Get(ctx context.Context, orgId uuid.UUID) (models.OrgOut, error) {
+	const op = "Organization.Get"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.String("organization id", orgId.String()),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := o.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.OrgOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get organization.
+	org, err := o.org(ctx, log, orgId)
+	if err != nil {
+		return models.OrgOut{}, err
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org.ToOut(), nil
+}
+
+// List returns organizations.
+func (o *Organization) List(ctx context.Context, limit, offset int32) ([]models.OrgOut, error) {
+	const op = "Organization.List"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := o.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get organizations.
+	res, err := o.orgStorage.Orgs(ctx, limit, offset)
+	if err != nil {
+		log.Error("failed to get organizations", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	out := make([]models.OrgOut, 0, len(res))
+	for i := range res {
+		out = append(out, res[i].ToOut())
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, nil
+}
+
+// Edit patches organization.
+func (o *Organization) Edit(ctx context.Context, orgId uuid.UUID, patch models.OrgPatch) (models.OrgOut, error) {
+	const op = "Organization.Edit"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.String("organization id", orgId.String()),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Check if user is allowed to manage organization.
+	org, err := o.manage(ctx, log, orgId)
+	if err != nil {
+		return models.OrgOut{}, err
+	}
+
+	// Apply patch.
+	org.Patch(patch)
+
+	// Update organization.
+	org, err = o.orgStorage.UpdateOrg(ctx, org)
+	if err != nil {
+		log.Error("failed to update organization", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.OrgOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org.ToOut(), nil
+}
+
+// Delete deletes organization.
+func (o *Organization) Delete(ctx context.Context, orgId uuid.UUID) error {
+	const op = "Organization.Delete"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.String("organization id", orgId.String()),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Check if user is allowed to manage organization.
+	if _, err := o.manage(ctx, log, orgId); err != nil {
+		return err
+	}
+
+	// Delete organization.
+	if err := o.orgStorage.DeleteOrg(ctx, orgId); err != nil {
+		if errors.Is(err, storage.ErrOrgNotFound) {
+			log.Warn("organization not found")
+			return service.ErrOrganizationNotFound
+		}
+		log.Error("failed to delete organization", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// Responsibles returns employees responsible for organization.
+func (o *Organization) Responsibles(ctx context.Context, orgId uuid.UUID, limit, offset int32) ([]models.ResponsibleOut, error) {
+	const op = "Organization.Responsibles"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.String("organization id", orgId.String()),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Check if user is allowed to view organization.
+	if _, err := o.permitted(ctx, log, orgId, models.ActionView); err != nil {
+		return nil, err
+	}
+
+	// Get responsibles.
+	res, err := o.orgStorage.Responsibles(ctx, orgId, limit, offset)
+	if err != nil {
+		log.Error("failed to get responsibles", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	out := make([]models.ResponsibleOut, 0, len(res))
+	for i := range res {
+		out = append(out, res[i].ToOut())
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, nil
+}
+
+// AddResponsible makes employee responsible for organization with given role.
+func (o *Organization) AddResponsible(ctx context.Context, orgId uuid.UUID, responsible models.ResponsibleNew) (models.ResponsibleOut, error) {
+	const op = "Organization.AddResponsible"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.String("organization id", orgId.String()),
+		slog.String("responsible", responsible.Username),
+		slog.String("role", string(responsible.Role)),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Check if user is allowed to manage organization.
+	if _, err := o.manage(ctx, log, orgId); err != nil {
+		return models.ResponsibleOut{}, err
+	}
+
+	// Check if new responsible exists.
+	if err := o.userSrv.Validate(ctx, responsible.Username); err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("responsible user not found")
+			return models.ResponsibleOut{}, service.ErrEmployeeNotFound
+		}
+		log.Error("failed to verify responsible user", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get new responsible's id.
+	userId, err := o.userSrv.UserId(ctx, responsible.Username)
+	if err != nil {
+		log.Error("failed to get user id", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Insert responsible.
+	if err := o.orgStorage.InsertResponsible(ctx, orgId, userId, responsible.Role); err != nil {
+		if errors.Is(err, storage.ErrAlreadyResponsible) {
+			log.Warn("user is already responsible")
+			return models.ResponsibleOut{}, service.ErrAlreadyResponsible
+		}
+		log.Error("failed to insert responsible", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get inserted responsible.
+	res, err := o.orgStorage.Responsible(ctx, orgId, userId)
+	if err != nil {
+		log.Error("failed to get responsible", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return res.ToOut(), nil
+}
+
+// RemoveResponsible removes employee from organization's responsibles.
+func (o *Organization) RemoveResponsible(ctx context.Context, orgId, userId uuid.UUID) error {
+	const op = "Organization.RemoveResponsible"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.String("organization id", orgId.String()),
+		slog.String("user id", userId.String()),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Check if user is allowed to manage organization.
+	if _, err := o.manage(ctx, log, orgId); err != nil {
+		return err
+	}
+
+	// Get responsible.
+	responsible, err := o.responsible(ctx, log, orgId, userId)
+	if err != nil {
+		return err
+	}
+
+	// Check if organization keeps an admin.
+	if err := o.keepAdmin(ctx, log, responsible, ""); err != nil {
+		return err
+	}
+
+	// Delete responsible.
+	if err := o.orgStorage.DeleteResponsible(ctx, orgId, userId); err != nil {
+		if errors.Is(err, storage.ErrNotResponsible) {
+			log.Warn("responsible not found")
+			return service.ErrResponsibleNotFound
+		}
+		log.Error("failed to delete responsible", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetResponsibleRole changes role of employee responsible for organization.
+func (o *Organization) SetResponsibleRole(ctx context.Context, orgId, userId uuid.UUID, patch models.ResponsiblePatch) (models.ResponsibleOut, error) {
+	const op = "Organization.SetResponsibleRole"
+
+	log := o.log.With(
+		slog.String("op", op),
+		slog.String("organization id", orgId.String()),
+		slog.String("user id", userId.String()),
+		slog.String("role", string(patch.Role)),
+	)
+
+	ctx, err := o.orgStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := o.orgStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Check if user is allowed to manage organization.
+	if _, err := o.manage(ctx, log, orgId); err != nil {
+		return models.ResponsibleOut{}, err
+	}
+
+	// Get responsible.
+	responsible, err := o.responsible(ctx, log, orgId, userId)
+	if err != nil {
+		return models.ResponsibleOut{}, err
+	}
+
+	// Check if organization keeps an admin.
+	if err := o.keepAdmin(ctx, log, responsible, patch.Role); err != nil {
+		return models.ResponsibleOut{}, err
+	}
+
+	// Update role.
+	if err := o.orgStorage.UpdateResponsible(ctx, orgId, userId, patch.Role); err != nil {
+		if errors.Is(err, storage.ErrNotResponsible) {
+			log.Warn("responsible not found")
+			return models.ResponsibleOut{}, service.ErrResponsibleNotFound
+		}
+		log.Error("failed to update responsible", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	responsible.Role = patch.Role
+
+	if err := o.orgStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.ResponsibleOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return responsible.ToOut(), nil
+}
+
+// responsible returns employee responsible for organization.
+func (o *Organization) responsible(ctx context.Context, log *slog.Logger, orgId, userId uuid.UUID) (models.Responsible, error) {
+	const op = "Organization.responsible"
+
+	responsible, err := o.orgStorage.Responsible(ctx, orgId, userId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotResponsible) {
+			log.Warn("responsible not found")
+			return models.Responsible{}, service.ErrResponsibleNotFound
+		}
+		log.Error("failed to get responsible", sl.Err(err))
+		return models.Responsible{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return responsible, nil
+}
+
+// keepAdmin checks that organization keeps at least one admin
+// after responsible gets new role. Empty role means removal.
+func (o *Organization) keepAdmin(ctx context.Context, log *slog.Logger, responsible models.Responsible, role models.Role) error {
+	const op = "Organization.keepAdmin"
+
+	if responsible.Role != models.RoleOrgAdmin || role == models.RoleOrgAdmin {
+		return nil
+	}
+
+	admins, err := o.orgStorage.OrgAdmins(ctx, responsible.OrgId)
+	if err != nil {
+		log.Error("failed to count admins", sl.Err(err))
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if admins <= 1 {
+		log.Warn("last admin can't be removed")
+		return service.ErrLastAdmin
+	}
+
+	return nil
+}
+
+// manage checks if user is allowed to manage existing organization.
+func (o *Organization) manage(ctx context.Context, log *slog.Logger, orgId uuid.UUID) (models.Org, error) {
+	return o.permitted(ctx, log, orgId, models.ActionManage)
+}
+
+// permitted returns organization if caller exists and
+// caller's role in organization allows action.
+func (o *Organization) permitted(ctx context.Context, log *slog.Logger, orgId uuid.UUID, action models.Action) (models.Org, error) {
+	const op = "Organization.permitted"
+
+	// Get caller.
+	username, err := o.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.Org{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.Org{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get organization.
+	org, err := o.org(ctx, log, orgId)
+	if err != nil {
+		return models.Org{}, err
+	}
+
+	// Check user's permission.
+	if err := o.userSrv.Permission(ctx, username, orgId, action); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("unallowed action", slog.String("action", string(action)))
+			return models.Org{}, err
+		}
+		log.Error("failed to check user permission", sl.Err(err))
+		return models.Org{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org, nil
+}
+
+// org returns organization by id.
+func (o *Organization) org(ctx context.Context, log *slog.Logger, orgId uuid.UUID) (models.Org, error) {
+	const op = "Organization.org"
+
+	org, err := o.orgStorage.Org(ctx, orgId)
+	if err != nil {
+		if errors.Is(err, storage.ErrOrgNotFound) {
+			log.Warn("organization not found")
+			return models.Org{}, service.ErrOrganizationNotFound
+		}
+		log.Error("failed to get organization", sl.Err(err))
+		return models.Org{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org, nil
+}
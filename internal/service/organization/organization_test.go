@@ -0,0 +1,421 @@
+package organization
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/service/organization/mocks"
+	"tender/internal/storage"
+)
+
+var (
+	USER_UUID = uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd")
+	ORG_UUID  = uuid.MustParse("002f9d2b-cd76-4921-8e53-21dbde75f993")
+)
+
+func TestAddResponsible(t *testing.T) {
+	type args struct {
+		ctx         context.Context
+		username    string
+		orgId       uuid.UUID
+		responsible models.ResponsibleNew
+	}
+	type want struct {
+		responsible models.ResponsibleOut
+		err         error
+	}
+	type orgRes struct {
+		err error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type validateRes struct {
+		err error
+	}
+	type insertRes struct {
+		err error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		want          want
+		orgRes        *orgRes
+		permissionRes *permissionRes
+		validateRes   *validateRes
+		insertRes     *insertRes
+	}{
+		{
+			name: "main line",
+			args: args{context.Background(), "admin", ORG_UUID, models.ResponsibleNew{
+				Username: "user",
+				Role:     models.RoleEditor,
+			}},
+			want: want{models.ResponsibleOut{
+				EmployeeOut: models.EmployeeOut{
+					EmployeeBase: models.EmployeeBase{Username: "user"},
+					Id:           USER_UUID,
+				},
+				OrgId: ORG_UUID,
+				Role:  models.RoleEditor,
+			}, nil},
+			orgRes:        &orgRes{nil},
+			permissionRes: &permissionRes{nil},
+			validateRes:   &validateRes{nil},
+			insertRes:     &insertRes{nil},
+		},
+		{
+			name:   "organization not found",
+			args:   args{context.Background(), "admin", ORG_UUID, models.ResponsibleNew{Username: "user"}},
+			want:   want{models.ResponsibleOut{}, service.ErrOrganizationNotFound},
+			orgRes: &orgRes{storage.ErrOrgNotFound},
+		},
+		{
+			name:          "no permissions",
+			args:          args{context.Background(), "editor", ORG_UUID, models.ResponsibleNew{Username: "user"}},
+			want:          want{models.ResponsibleOut{}, &service.PermissionError{Action: models.ActionManage}},
+			orgRes:        &orgRes{nil},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionManage}},
+		},
+		{
+			name:          "employee not found",
+			args:          args{context.Background(), "admin", ORG_UUID, models.ResponsibleNew{Username: "user"}},
+			want:          want{models.ResponsibleOut{}, service.ErrEmployeeNotFound},
+			orgRes:        &orgRes{nil},
+			permissionRes: &permissionRes{nil},
+			validateRes:   &validateRes{service.ErrUserNotFound},
+		},
+		{
+			name:          "already responsible",
+			args:          args{context.Background(), "admin", ORG_UUID, models.ResponsibleNew{Username: "user"}},
+			want:          want{models.ResponsibleOut{}, service.ErrAlreadyResponsible},
+			orgRes:        &orgRes{nil},
+			permissionRes: &permissionRes{nil},
+			validateRes:   &validateRes{nil},
+			insertRes:     &insertRes{storage.ErrAlreadyResponsible},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			oStorage := mocks.NewOrgStorage(t)
+
+			oStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", mock.Anything).
+				Return(tt.args.username, nil)
+			if tt.orgRes != nil {
+				oStorage.
+					On("Org", mock.Anything, tt.args.orgId).
+					Return(models.Org{Id: tt.args.orgId}, tt.orgRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", mock.Anything, tt.args.username, tt.args.orgId, models.ActionManage).
+					Return(tt.permissionRes.err)
+			}
+			if tt.validateRes != nil {
+				user.
+					On("Validate", mock.Anything, tt.args.responsible.Username).
+					Return(tt.validateRes.err)
+				if tt.validateRes.err == nil {
+					user.
+						On("UserId", mock.Anything, tt.args.responsible.Username).
+						Return(USER_UUID, nil)
+				}
+			}
+			if tt.insertRes != nil {
+				oStorage.
+					On("InsertResponsible", mock.Anything, tt.args.orgId, USER_UUID, tt.args.responsible.Role).
+					Return(tt.insertRes.err)
+				if tt.insertRes.err == nil {
+					oStorage.
+						On("Responsible", mock.Anything, tt.args.orgId, USER_UUID).
+						Return(models.Responsible{
+							Employee: models.Employee{
+								EmployeeBase: models.EmployeeBase{Username: tt.args.responsible.Username},
+								Id:           USER_UUID,
+							},
+							OrgId: tt.args.orgId,
+							Role:  tt.args.responsible.Role,
+						}, nil)
+					oStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			oStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			org := Organization{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:    user,
+				orgStorage: oStorage,
+			}
+
+			res, err := org.AddResponsible(tt.args.ctx, tt.args.orgId, tt.args.responsible)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.responsible, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
+
+func TestRemoveResponsible(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		orgId    uuid.UUID
+		userId   uuid.UUID
+	}
+	type responsibleRes struct {
+		role models.Role
+		err  error
+	}
+	type adminsRes struct {
+		admins int64
+	}
+	type deleteRes struct {
+		err error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		responsibleRes *responsibleRes
+		adminsRes      *adminsRes
+		deleteRes      *deleteRes
+		want           error
+	}{
+		{
+			name:           "main line",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID},
+			responsibleRes: &responsibleRes{models.RoleEditor, nil},
+			deleteRes:      &deleteRes{nil},
+		},
+		{
+			name:           "one of admins",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID},
+			responsibleRes: &responsibleRes{models.RoleOrgAdmin, nil},
+			adminsRes:      &adminsRes{2},
+			deleteRes:      &deleteRes{nil},
+		},
+		{
+			name:           "last admin",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID},
+			responsibleRes: &responsibleRes{models.RoleOrgAdmin, nil},
+			adminsRes:      &adminsRes{1},
+			want:           service.ErrLastAdmin,
+		},
+		{
+			name:           "responsible not found",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID},
+			responsibleRes: &responsibleRes{"", storage.ErrNotResponsible},
+			want:           service.ErrResponsibleNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			oStorage := mocks.NewOrgStorage(t)
+
+			oStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", mock.Anything).
+				Return(tt.args.username, nil)
+			oStorage.
+				On("Org", mock.Anything, tt.args.orgId).
+				Return(models.Org{Id: tt.args.orgId}, nil)
+			user.
+				On("Permission", mock.Anything, tt.args.username, tt.args.orgId, models.ActionManage).
+				Return(nil)
+			if tt.responsibleRes != nil {
+				oStorage.
+					On("Responsible", mock.Anything, tt.args.orgId, tt.args.userId).
+					Return(models.Responsible{
+						Employee: models.Employee{Id: tt.args.userId},
+						OrgId:    tt.args.orgId,
+						Role:     tt.responsibleRes.role,
+					}, tt.responsibleRes.err)
+			}
+			if tt.adminsRes != nil {
+				oStorage.
+					On("OrgAdmins", mock.Anything, tt.args.orgId).
+					Return(tt.adminsRes.admins, nil)
+			}
+			if tt.deleteRes != nil {
+				oStorage.
+					On("DeleteResponsible", mock.Anything, tt.args.orgId, tt.args.userId).
+					Return(tt.deleteRes.err)
+				if tt.deleteRes.err == nil {
+					oStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			oStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			org := Organization{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:    user,
+				orgStorage: oStorage,
+			}
+
+			err := org.RemoveResponsible(tt.args.ctx, tt.args.orgId, tt.args.userId)
+			if tt.want == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.want.Error())
+			}
+		})
+	}
+}
+
+func TestSetResponsibleRole(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		orgId    uuid.UUID
+		userId   uuid.UUID
+		patch    models.ResponsiblePatch
+	}
+	type want struct {
+		responsible models.ResponsibleOut
+		err         error
+	}
+	type responsibleRes struct {
+		role models.Role
+		err  error
+	}
+	type adminsRes struct {
+		admins int64
+	}
+	type updateRes struct {
+		err error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		responsibleRes *responsibleRes
+		adminsRes      *adminsRes
+		updateRes      *updateRes
+		want           want
+	}{
+		{
+			name:           "promote",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID, models.ResponsiblePatch{Role: models.RoleOrgAdmin}},
+			responsibleRes: &responsibleRes{models.RoleEditor, nil},
+			updateRes:      &updateRes{nil},
+			want: want{models.ResponsibleOut{
+				EmployeeOut: models.EmployeeOut{Id: USER_UUID},
+				OrgId:       ORG_UUID,
+				Role:        models.RoleOrgAdmin,
+			}, nil},
+		},
+		{
+			name:           "demote one of admins",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID, models.ResponsiblePatch{Role: models.RoleViewer}},
+			responsibleRes: &responsibleRes{models.RoleOrgAdmin, nil},
+			adminsRes:      &adminsRes{2},
+			updateRes:      &updateRes{nil},
+			want: want{models.ResponsibleOut{
+				EmployeeOut: models.EmployeeOut{Id: USER_UUID},
+				OrgId:       ORG_UUID,
+				Role:        models.RoleViewer,
+			}, nil},
+		},
+		{
+			name:           "demote last admin",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID, models.ResponsiblePatch{Role: models.RoleViewer}},
+			responsibleRes: &responsibleRes{models.RoleOrgAdmin, nil},
+			adminsRes:      &adminsRes{1},
+			want:           want{models.ResponsibleOut{}, service.ErrLastAdmin},
+		},
+		{
+			name:           "responsible not found",
+			args:           args{context.Background(), "admin", ORG_UUID, USER_UUID, models.ResponsiblePatch{Role: models.RoleViewer}},
+			responsibleRes: &responsibleRes{"", storage.ErrNotResponsible},
+			want:           want{models.ResponsibleOut{}, service.ErrResponsibleNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			oStorage := mocks.NewOrgStorage(t)
+
+			oStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", mock.Anything).
+				Return(tt.args.username, nil)
+			oStorage.
+				On("Org", mock.Anything, tt.args.orgId).
+				Return(models.Org{Id: tt.args.orgId}, nil)
+			user.
+				On("Permission", mock.Anything, tt.args.username, tt.args.orgId, models.ActionManage).
+				Return(nil)
+			if tt.responsibleRes != nil {
+				oStorage.
+					On("Responsible", mock.Anything, tt.args.orgId, tt.args.userId).
+					Return(models.Responsible{
+						Employee: models.Employee{Id: tt.args.userId},
+						OrgId:    tt.args.orgId,
+						Role:     tt.responsibleRes.role,
+					}, tt.responsibleRes.err)
+			}
+			if tt.adminsRes != nil {
+				oStorage.
+					On("OrgAdmins", mock.Anything, tt.args.orgId).
+					Return(tt.adminsRes.admins, nil)
+			}
+			if tt.updateRes != nil {
+				oStorage.
+					On("UpdateResponsible", mock.Anything, tt.args.orgId, tt.args.userId, tt.args.patch.Role).
+					Return(tt.updateRes.err)
+				if tt.updateRes.err == nil {
+					oStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			oStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			org := Organization{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:    user,
+				orgStorage: oStorage,
+			}
+
+			res, err := org.SetResponsibleRole(tt.args.ctx, tt.args.orgId, tt.args.userId, tt.args.patch)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.responsible, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package question
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"tender/internal/lib/logger/sl"
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+type Question struct {
+	log             *slog.Logger
+	userSrv         UserService
+	tenderSrv       TenderService
+	questionStorage QuestionStorage
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name UserService
+type UserService interface {
+	Caller(ctx context.Context) (string, error)
+	UserId(ctx context.Context, username string) (uuid.UUID, error)
+	Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name TenderService
+type TenderService interface {
+	Tender(ctx context.Context, id uuid.UUID) (models.Tender, error)
+}
+
+//go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name QuestionStorage
+type QuestionStorage interface {
+	Begin(ctx context.Context) (context.Context, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+
+	InsertQuestion(ctx context.Context, question models.Question) (models.Question, error)
+	Question(ctx context.Context, questionId uuid.UUID) (models.Question, error)
+	Questions(ctx context.Context, tenderId, userId uuid.UUID, all bool, limit, offset int32, after *models.Cursor) ([]models.Question, *models.Cursor, error)
+	UpsertAnswer(ctx context.Context, answer models.Answer) (models.Answer, error)
+}
+
+func New(
+	log *slog.Logger,
+	userSrv UserService,
+	tenderSrv TenderService,
+	questionStorage QuestionStorage,
+) *Question {
+	return &Question{
+		log:             log,
+		userSrv:         userSrv,
+		tenderSrv:       tenderSrv,
+		questionStorage: questionStorage,
+	}
+}
+
+// Ask saves user's question on published tender.
+// Responsibles of tender's organization can't ask.
+func (q *Question) Ask(ctx context.Context, tenderId uuid.UUID, questionNew models.QuestionNew) (models.QuestionOut, error) {
+	const op = "Question.Ask"
+
+	log := q.log.With(
+		slog.String("op", op),
+		slog.String("tender id", tenderId.String()),
+	)
+
+	ctx, err := q.questionStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := q.questionStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := q.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.QuestionOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := q.tender(ctx, log, tenderId)
+	if err != nil {
+		return models.QuestionOut{}, err
+	}
+
+	// Questions are asked only while tender accepts bids.
+	if tender.Status != models.TenderPublished {
+		log.Warn("tender is not published", slog.String("status", string(tender.Status)))
+		return models.QuestionOut{}, service.ErrTenderNotPublished
+	}
+
+	// Responsibles of tender's organization answer questions, not ask them.
+	err = q.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView)
+	if err == nil {
+		log.Warn("responsible can't ask on own tender")
+		return models.QuestionOut{}, service.ErrOwnTender
+	}
+	if !errors.Is(err, service.ErrNotEnoughPrivileges) {
+		log.Error("failed to check permission", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get user id.
+	userId, err := q.userSrv.UserId(ctx, username)
+	if err != nil {
+		log.Error("failed to get user id", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Save question.
+	question, err := q.questionStorage.InsertQuestion(ctx, questionNew.ToQuestion(userId, tenderId))
+	if err != nil {
+		log.Error("failed to insert question", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	question.Username = username
+
+	if err := q.questionStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return question.ToOut(), nil
+}
+
+// Questions returns tender's questions, earliest first.
+// Responsibles see all questions, other users see their own
+// questions and questions with public answers, askers of the latter are hidden.
+// Returns cursor of the next page if there is one.
+func (q *Question) Questions(ctx context.Context, tenderId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.QuestionOut, *models.Cursor, error) {
+	const op = "Question.Questions"
+
+	log := q.log.With(
+		slog.String("op", op),
+		slog.String("tender id", tenderId.String()),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	ctx, err := q.questionStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := q.questionStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := q.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := q.tender(ctx, log, tenderId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check if user is responsible for tender.
+	responsible := true
+	if err := q.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if !errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Error("failed to check permission", sl.Err(err))
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		responsible = false
+	}
+
+	// Drafts and closed tenders are visible only to responsibles.
+	if !responsible && tender.Status != models.TenderPublished {
+		log.Warn("unallowed to view", slog.String("status", string(tender.Status)))
+		return nil, nil, &service.PermissionError{Action: models.ActionView}
+	}
+
+	// Get user id.
+	userId, err := q.userSrv.UserId(ctx, username)
+	if err != nil {
+		log.Error("failed to get user id", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get questions.
+	questions, next, err := q.questionStorage.Questions(ctx, tenderId, userId, responsible, limit, offset, after)
+	if err != nil {
+		log.Error("failed to get questions", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := q.questionStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	res := make([]models.QuestionOut, 0, len(questions))
+	for _, question := range questions {
+		// Don't disclose competitors to each other.
+		if !responsible && question.UserId != userId {
+			question.Username = ""
+		}
+		res = append(res, question.ToOut())
+	}
+
+	return res, next, nil
+}
+
+// Answer saves answer of tender's responsible on question.
+// Previous answer on the question is replaced.
+func (q *Question) Answer(ctx context.Context, tenderId, questionId uuid.UUID, answerNew models.AnswerNew) (models.QuestionOut, error) {
+	const op = "Question.Answer"
+
+	log := q.log.With(
+		slog.String("op", op),
+		slog.String("tender id", tenderId.String()),
+		slog.String("question id", questionId.String()),
+	)
+
+	ctx, err := q.questionStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := q.questionStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := q.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.QuestionOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := q.tender(ctx, log, tenderId)
+	if err != nil {
+		return models.QuestionOut{}, err
+	}
+
+	// Any responsible of tender's organization can answer.
+	if err := q.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("user not allowed")
+			return models.QuestionOut{}, err
+		}
+		log.Error("failed to check permission", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get question.
+	question, err := q.questionStorage.Question(ctx, questionId)
+	if err != nil {
+		if errors.Is(err, storage.ErrQuestionNotFound) {
+			log.Warn("question not found")
+			return models.QuestionOut{}, service.ErrQuestionNotFound
+		}
+		log.Error("failed to get question", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if question.TenderId != tenderId {
+		log.Warn("question belongs to another tender")
+		return models.QuestionOut{}, service.ErrQuestionNotFound
+	}
+
+	// Get user id.
+	userId, err := q.userSrv.UserId(ctx, username)
+	if err != nil {
+		log.Error("failed to get user id", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Save answer.
+	answer, err := q.questionStorage.UpsertAnswer(ctx, answerNew.ToAnswer(userId, questionId))
+	if err != nil {
+		log.Error("failed to save answer", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	answer.Username = username
+	question.Answer = &answer
+
+	if err := q.questionStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.QuestionOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return question.ToOut(), nil
+}
+
+// tender returns tender by id.
+func (q *Question) tender(ctx context.Context, log *slog.Logger, tenderId uuid.UUID) (models.Tender, error) {
+	const op = "Question.tender"
+
+	tender, err := q.tenderSrv.Tender(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, service.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.Tender{}, err
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tender, nil
+}
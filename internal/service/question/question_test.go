@@ -0,0 +1,423 @@
+package question
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"tender/internal/models"
+	"tender/internal/service"
+	"tender/internal/service/question/mocks"
+	"tender/internal/storage"
+)
+
+var (
+	USER_UUID     = uuid.MustParse("98abb192-f64d-44d6-9fcb-a2b0844c62bd")
+	ASKER_UUID    = uuid.MustParse("ce61bdc8-d435-454a-92c7-5e51c9a21907")
+	ORG_UUID      = uuid.MustParse("002f9d2b-cd76-4921-8e53-21dbde75f993")
+	TENDER_UUID   = uuid.MustParse("3fa85f64-5717-4562-b3fc-2c963f66afa6")
+	QUESTION_UUID = uuid.MustParse("0ee22c35-f8e7-4c1d-a0b4-2f4e3d1bba8c")
+)
+
+func TestAsk(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		tenderId uuid.UUID
+	}
+	type want struct {
+		question models.QuestionOut
+		err      error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type insertRes struct {
+		question models.Question
+		err      error
+	}
+	askedAt := time.Unix(10000, 0)
+	tests := []struct {
+		name          string
+		args          args
+		tenderRes     *tenderRes
+		permissionRes *permissionRes
+		insertRes     *insertRes
+		want          want
+	}{
+		{
+			name:          "main line",
+			args:          args{context.Background(), "supplier", TENDER_UUID},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+			insertRes: &insertRes{models.Question{
+				Id:        QUESTION_UUID,
+				TenderId:  TENDER_UUID,
+				UserId:    ASKER_UUID,
+				Text:      "is delivery included?",
+				CreatedAt: askedAt,
+			}, nil},
+			want: want{models.QuestionOut{
+				Id:        QUESTION_UUID,
+				Username:  "supplier",
+				Text:      "is delivery included?",
+				CreatedAt: askedAt,
+			}, nil},
+		},
+		{
+			name:      "tender not found",
+			args:      args{context.Background(), "supplier", TENDER_UUID},
+			tenderRes: &tenderRes{models.Tender{}, service.ErrTenderNotFound},
+			want:      want{models.QuestionOut{}, service.ErrTenderNotFound},
+		},
+		{
+			name:          "own organization's tender",
+			args:          args{context.Background(), "employee", TENDER_UUID},
+			tenderRes:     &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			want:          want{models.QuestionOut{}, service.ErrOwnTender},
+		},
+		{
+			name:      "tender closed",
+			args:      args{context.Background(), "supplier", TENDER_UUID},
+			tenderRes: &tenderRes{models.Tender{Id: TENDER_UUID, Status: models.TenderClosed}, nil},
+			want:      want{models.QuestionOut{}, service.ErrTenderNotPublished},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			qStorage := mocks.NewQuestionStorage(t)
+
+			qStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.tenderRes != nil {
+				tenderSrv.
+					On("Tender", tt.args.ctx, tt.args.tenderId).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.insertRes != nil {
+				user.
+					On("UserId", tt.args.ctx, tt.args.username).
+					Return(ASKER_UUID, nil)
+				qStorage.
+					On("InsertQuestion", tt.args.ctx, models.Question{
+						TenderId: tt.args.tenderId,
+						UserId:   ASKER_UUID,
+						Text:     "is delivery included?",
+					}).
+					Return(tt.insertRes.question, tt.insertRes.err)
+				qStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			qStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			question := Question{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:         user,
+				tenderSrv:       tenderSrv,
+				questionStorage: qStorage,
+			}
+
+			res, err := question.Ask(tt.args.ctx, tt.args.tenderId, models.QuestionNew{Text: "is delivery included?"})
+			assert.Equal(t, tt.want.question, res)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestQuestions(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		tenderId uuid.UUID
+	}
+	type want struct {
+		questions []models.QuestionOut
+		err       error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type questionsRes struct {
+		all       bool
+		questions []models.Question
+	}
+	askedAt := time.Unix(10000, 0)
+	answered := models.Question{
+		Id:        QUESTION_UUID,
+		TenderId:  TENDER_UUID,
+		UserId:    ASKER_UUID,
+		Username:  "supplier",
+		Text:      "is delivery included?",
+		CreatedAt: askedAt,
+		Answer: &models.Answer{
+			Username:  "responsible",
+			Text:      "yes",
+			Public:    true,
+			CreatedAt: askedAt,
+		},
+	}
+	tests := []struct {
+		name          string
+		args          args
+		status        models.TenderStatus
+		permissionRes *permissionRes
+		questionsRes  *questionsRes
+		want          want
+	}{
+		{
+			name:          "responsible",
+			args:          args{context.Background(), "responsible", TENDER_UUID},
+			status:        models.TenderClosed,
+			permissionRes: &permissionRes{nil},
+			questionsRes:  &questionsRes{true, []models.Question{answered}},
+			want:          want{[]models.QuestionOut{answered.ToOut()}, nil},
+		},
+		{
+			name:          "other bidder",
+			args:          args{context.Background(), "competitor", TENDER_UUID},
+			status:        models.TenderPublished,
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+			questionsRes:  &questionsRes{false, []models.Question{answered}},
+			want: want{[]models.QuestionOut{{
+				Id:        QUESTION_UUID,
+				Text:      "is delivery included?",
+				CreatedAt: askedAt,
+				Answer:    &models.AnswerOut{Username: "responsible", Text: "yes", Public: true, CreatedAt: askedAt},
+			}}, nil},
+		},
+		{
+			name:          "closed tender",
+			args:          args{context.Background(), "competitor", TENDER_UUID},
+			status:        models.TenderClosed,
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+			want:          want{nil, service.ErrNotEnoughPrivileges},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			qStorage := mocks.NewQuestionStorage(t)
+
+			qStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			tenderSrv.
+				On("Tender", tt.args.ctx, tt.args.tenderId).
+				Return(models.Tender{Id: TENDER_UUID, Status: tt.status, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil)
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.questionsRes != nil {
+				user.
+					On("UserId", tt.args.ctx, tt.args.username).
+					Return(USER_UUID, nil)
+				qStorage.
+					On("Questions", tt.args.ctx, tt.args.tenderId, USER_UUID, tt.questionsRes.all, int32(5), int32(0), (*models.Cursor)(nil)).
+					Return(tt.questionsRes.questions, nil, nil)
+				qStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			qStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			question := Question{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:         user,
+				tenderSrv:       tenderSrv,
+				questionStorage: qStorage,
+			}
+
+			res, next, err := question.Questions(tt.args.ctx, tt.args.tenderId, 5, 0, nil)
+			assert.Nil(t, next)
+			assert.Equal(t, tt.want.questions, res)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestAnswer(t *testing.T) {
+	type args struct {
+		ctx        context.Context
+		username   string
+		tenderId   uuid.UUID
+		questionId uuid.UUID
+	}
+	type want struct {
+		question models.QuestionOut
+		err      error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type questionRes struct {
+		question models.Question
+		err      error
+	}
+	type upsertRes struct {
+		answer models.Answer
+		err    error
+	}
+	askedAt := time.Unix(10000, 0)
+	answeredAt := time.Unix(20000, 0)
+	asked := models.Question{
+		Id:        QUESTION_UUID,
+		TenderId:  TENDER_UUID,
+		UserId:    ASKER_UUID,
+		Username:  "supplier",
+		Text:      "is delivery included?",
+		CreatedAt: askedAt,
+	}
+	tests := []struct {
+		name          string
+		args          args
+		permissionRes *permissionRes
+		questionRes   *questionRes
+		upsertRes     *upsertRes
+		want          want
+	}{
+		{
+			name:          "main line",
+			args:          args{context.Background(), "responsible", TENDER_UUID, QUESTION_UUID},
+			permissionRes: &permissionRes{nil},
+			questionRes:   &questionRes{asked, nil},
+			upsertRes: &upsertRes{models.Answer{
+				QuestionId: QUESTION_UUID,
+				UserId:     USER_UUID,
+				Text:       "yes",
+				Public:     true,
+				CreatedAt:  answeredAt,
+			}, nil},
+			want: want{models.QuestionOut{
+				Id:        QUESTION_UUID,
+				Username:  "supplier",
+				Text:      "is delivery included?",
+				CreatedAt: askedAt,
+				Answer:    &models.AnswerOut{Username: "responsible", Text: "yes", Public: true, CreatedAt: answeredAt},
+			}, nil},
+		},
+		{
+			name:          "not responsible",
+			args:          args{context.Background(), "supplier", TENDER_UUID, QUESTION_UUID},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+			want:          want{models.QuestionOut{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name:          "question not found",
+			args:          args{context.Background(), "responsible", TENDER_UUID, QUESTION_UUID},
+			permissionRes: &permissionRes{nil},
+			questionRes:   &questionRes{models.Question{}, storage.ErrQuestionNotFound},
+			want:          want{models.QuestionOut{}, service.ErrQuestionNotFound},
+		},
+		{
+			name:          "question of another tender",
+			args:          args{context.Background(), "responsible", TENDER_UUID, QUESTION_UUID},
+			permissionRes: &permissionRes{nil},
+			questionRes:   &questionRes{models.Question{Id: QUESTION_UUID, TenderId: uuid.New()}, nil},
+			want:          want{models.QuestionOut{}, service.ErrQuestionNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tenderSrv := mocks.NewTenderService(t)
+			qStorage := mocks.NewQuestionStorage(t)
+
+			qStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			tenderSrv.
+				On("Tender", tt.args.ctx, tt.args.tenderId).
+				Return(models.Tender{Id: TENDER_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil)
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.questionRes != nil {
+				qStorage.
+					On("Question", tt.args.ctx, tt.args.questionId).
+					Return(tt.questionRes.question, tt.questionRes.err)
+			}
+			if tt.upsertRes != nil {
+				user.
+					On("UserId", tt.args.ctx, tt.args.username).
+					Return(USER_UUID, nil)
+				qStorage.
+					On("UpsertAnswer", tt.args.ctx, models.Answer{
+						QuestionId: tt.args.questionId,
+						UserId:     USER_UUID,
+						Text:       "yes",
+						Public:     true,
+					}).
+					Return(tt.upsertRes.answer, tt.upsertRes.err)
+				qStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			qStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			question := Question{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:         user,
+				tenderSrv:       tenderSrv,
+				questionStorage: qStorage,
+			}
+
+			res, err := question.Answer(tt.args.ctx, tt.args.tenderId, tt.args.questionId, models.AnswerNew{Text: "yes", Public: true})
+			assert.Equal(t, tt.want.question, res)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
@@ -16,6 +16,36 @@ type RollbackStorage struct {
 	mock.Mock
 }
 
+// BidVersions provides a mock function with given fields: ctx, bidId, limit, offset
+func (_m *RollbackStorage) BidVersions(ctx context.Context, bidId uuid.UUID, limit int32, offset int32) ([]models.Bid, error) {
+	ret := _m.Called(ctx, bidId, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BidVersions")
+	}
+
+	var r0 []models.Bid
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) ([]models.Bid, error)); ok {
+		return rf(ctx, bidId, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) []models.Bid); ok {
+		r0 = rf(ctx, bidId, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Bid)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32) error); ok {
+		r1 = rf(ctx, bidId, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecoverBid provides a mock function with given fields: ctx, bidId, version
 func (_m *RollbackStorage) RecoverBid(ctx context.Context, bidId uuid.UUID, version int32) (models.Bid, error) {
 	ret := _m.Called(ctx, bidId, version)
@@ -108,6 +138,36 @@ func (_m *RollbackStorage) SaveTender(ctx context.Context, tender models.Tender)
 	return r0
 }
 
+// TenderVersions provides a mock function with given fields: ctx, tenderId, limit, offset
+func (_m *RollbackStorage) TenderVersions(ctx context.Context, tenderId uuid.UUID, limit int32, offset int32) ([]models.Tender, error) {
+	ret := _m.Called(ctx, tenderId, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenderVersions")
+	}
+
+	var r0 []models.Tender
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) ([]models.Tender, error)); ok {
+		return rf(ctx, tenderId, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) []models.Tender); ok {
+		r0 = rf(ctx, tenderId, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tender)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32) error); ok {
+		r1 = rf(ctx, tenderId, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRollbackStorage creates a new instance of RollbackStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRollbackStorage(t interface {
@@ -35,6 +35,8 @@ type RollbackStorage interface {
 	SaveBid(ctx context.Context, bid models.Bid) error
 	RecoverTender(ctx context.Context, tenderId uuid.UUID, version int32) (models.Tender, error)
 	RecoverBid(ctx context.Context, bidId uuid.UUID, version int32) (models.Bid, error)
+	TenderVersions(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.Tender, error)
+	BidVersions(ctx context.Context, bidId uuid.UUID, limit, offset int32) ([]models.Bid, error)
 }
 
 // SaveTender saves outdated tender.
@@ -132,3 +134,93 @@ func (r *Rollback) SwapBid(ctx context.Context, bidId uuid.UUID, version int32,
 
 	return oldBid, nil
 }
+
+// TenderVersions returns outdated versions of tender.
+func (r *Rollback) TenderVersions(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.Tender, error) {
+	const op = "Rollback.TenderVersions"
+
+	log := r.log.With(
+		slog.String("op", op),
+		slog.String("id", tenderId.String()),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	// Get versions.
+	tenders, err := r.rollbackStorage.TenderVersions(ctx, tenderId, limit, offset)
+	if err != nil {
+		log.Error("failed to get tender versions", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tenders, nil
+}
+
+// BidVersions returns outdated versions of bid.
+func (r *Rollback) BidVersions(ctx context.Context, bidId uuid.UUID, limit, offset int32) ([]models.Bid, error) {
+	const op = "Rollback.BidVersions"
+
+	log := r.log.With(
+		slog.String("op", op),
+		slog.String("id", bidId.String()),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	// Get versions.
+	bids, err := r.rollbackStorage.BidVersions(ctx, bidId, limit, offset)
+	if err != nil {
+		log.Error("failed to get bid versions", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bids, nil
+}
+
+// TenderVersion returns outdated version of tender.
+func (r *Rollback) TenderVersion(ctx context.Context, tenderId uuid.UUID, version int32) (models.Tender, error) {
+	const op = "Rollback.TenderVersion"
+
+	log := r.log.With(
+		slog.String("op", op),
+		slog.String("id", tenderId.String()),
+		slog.Int("version", int(version)),
+	)
+
+	// Get old tender.
+	tender, err := r.rollbackStorage.RecoverTender(ctx, tenderId, version)
+	if err != nil {
+		if errors.Is(err, storage.ErrVersionNotFound) {
+			log.Warn("version not found")
+			return models.Tender{}, service.ErrVersionNotFound
+		}
+		log.Error("failed to get tender version", sl.Err(err))
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tender, nil
+}
+
+// BidVersion returns outdated version of bid.
+func (r *Rollback) BidVersion(ctx context.Context, bidId uuid.UUID, version int32) (models.Bid, error) {
+	const op = "Rollback.BidVersion"
+
+	log := r.log.With(
+		slog.String("op", op),
+		slog.String("id", bidId.String()),
+		slog.Int("version", int(version)),
+	)
+
+	// Get old bid.
+	bid, err := r.rollbackStorage.RecoverBid(ctx, bidId, version)
+	if err != nil {
+		if errors.Is(err, storage.ErrVersionNotFound) {
+			log.Warn("version not found")
+			return models.Bid{}, service.ErrVersionNotFound
+		}
+		log.Error("failed to get bid version", sl.Err(err))
+		return models.Bid{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return bid, nil
+}
@@ -1,15 +1,87 @@
 package service
 
-import "errors"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tender/internal/models"
+
+	"github.com/google/uuid"
+)
 
 var (
+	ErrUnauthorized = errors.New("caller is not authenticated")
+
 	ErrUserNotFound         = errors.New("user not found")
+	ErrEmployeeNotFound     = errors.New("employee not found")
 	ErrOrganizationNotFound = errors.New("organization not found")
 	ErrTenderNotFound       = errors.New("tender not found")
 	ErrBidNotFound          = errors.New("bid not found")
 	ErrVersionNotFound      = errors.New("version not found")
 	ErrReviewsNotFound      = errors.New("reviews not found")
 	ErrAuthorNotFound       = errors.New("author not found")
+	ErrCriterionNotFound    = errors.New("criterion not found")
+	ErrQuestionNotFound     = errors.New("question not found")
 
 	ErrNotEnoughPrivileges = errors.New("not enought privileges")
+	ErrConflictOfInterest  = errors.New("user can't decide on own bid")
+	ErrOwnTender           = errors.New("user can't ask on own organization's tender")
+
+	ErrUsernameTaken       = errors.New("username is taken")
+	ErrAlreadyResponsible  = errors.New("user is already responsible")
+	ErrResponsibleNotFound = errors.New("responsible not found")
+	ErrLastAdmin           = errors.New("organization must keep at least one admin")
+
+	ErrInvalidTransition = errors.New("invalid status transition")
+	ErrVersionConflict   = errors.New("version conflict")
+	ErrDeadlinePassed    = errors.New("tender deadline has passed")
+
+	ErrTenderNotPublished = errors.New("tender is not published")
+	ErrBidNotPublished    = errors.New("bid is not published")
+	ErrOverBudget         = errors.New("bid price exceeds tender budget")
+	ErrCurrencyMismatch   = errors.New("bid currency differs from tender currency")
 )
+
+// PermissionError is returned when user's role in organization
+// doesn't allow action. It matches ErrNotEnoughPrivileges.
+type PermissionError struct {
+	Action models.Action
+}
+
+func (e *PermissionError) Error() string {
+	return fmt.Sprintf("%s permission required", e.Action)
+}
+
+func (e *PermissionError) Is(target error) bool {
+	return target == ErrNotEnoughPrivileges
+}
+
+// PermissionChecker checks if user is allowed to perform action in organization.
+type PermissionChecker interface {
+	Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error
+}
+
+// OwnBid returns ErrConflictOfInterest if bid is authored
+// by user or by organization user is member of.
+func OwnBid(ctx context.Context, perm PermissionChecker, username string, userId uuid.UUID, bid models.Bid) error {
+	const op = "service.OwnBid"
+
+	switch bid.AuthorType {
+	case models.User:
+		if bid.AuthorId == userId {
+			return ErrConflictOfInterest
+		}
+	case models.Organization:
+		// Any role in organization is enough to view its bids.
+		err := perm.Permission(ctx, username, bid.AuthorId, models.ActionView)
+		if err == nil {
+			return ErrConflictOfInterest
+		}
+		if !errors.Is(err, ErrNotEnoughPrivileges) {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
@@ -62,6 +62,64 @@ func (_m *RollbackService) SwapTender(ctx context.Context, tenderId uuid.UUID, v
 	return r0, r1
 }
 
+// TenderVersion provides a mock function with given fields: ctx, tenderId, version
+func (_m *RollbackService) TenderVersion(ctx context.Context, tenderId uuid.UUID, version int32) (models.Tender, error) {
+	ret := _m.Called(ctx, tenderId, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenderVersion")
+	}
+
+	var r0 models.Tender
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) (models.Tender, error)); ok {
+		return rf(ctx, tenderId, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32) models.Tender); ok {
+		r0 = rf(ctx, tenderId, version)
+	} else {
+		r0 = ret.Get(0).(models.Tender)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32) error); ok {
+		r1 = rf(ctx, tenderId, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TenderVersions provides a mock function with given fields: ctx, tenderId, limit, offset
+func (_m *RollbackService) TenderVersions(ctx context.Context, tenderId uuid.UUID, limit int32, offset int32) ([]models.Tender, error) {
+	ret := _m.Called(ctx, tenderId, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenderVersions")
+	}
+
+	var r0 []models.Tender
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) ([]models.Tender, error)); ok {
+		return rf(ctx, tenderId, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int32, int32) []models.Tender); ok {
+		r0 = rf(ctx, tenderId, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tender)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int32, int32) error); ok {
+		r1 = rf(ctx, tenderId, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewRollbackService creates a new instance of RollbackService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRollbackService(t interface {
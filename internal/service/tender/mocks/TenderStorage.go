@@ -46,6 +46,36 @@ func (_m *TenderStorage) Begin(ctx context.Context) (context.Context, error) {
 	return r0, r1
 }
 
+// CloseOverdueTenders provides a mock function with given fields: ctx
+func (_m *TenderStorage) CloseOverdueTenders(ctx context.Context) ([]models.Tender, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseOverdueTenders")
+	}
+
+	var r0 []models.Tender
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Tender, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Tender); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tender)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Commit provides a mock function with given fields: ctx
 func (_m *TenderStorage) Commit(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -166,75 +196,56 @@ func (_m *TenderStorage) TenderSetStatus(ctx context.Context, tenderId uuid.UUID
 	return r0, r1
 }
 
-// TenderStatus provides a mock function with given fields: ctx, tenderId
-func (_m *TenderStorage) TenderStatus(ctx context.Context, tenderId uuid.UUID) (models.TenderStatus, error) {
-	ret := _m.Called(ctx, tenderId)
-
-	if len(ret) == 0 {
-		panic("no return value specified for TenderStatus")
-	}
-
-	var r0 models.TenderStatus
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.TenderStatus, error)); ok {
-		return rf(ctx, tenderId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.TenderStatus); ok {
-		r0 = rf(ctx, tenderId)
-	} else {
-		r0 = ret.Get(0).(models.TenderStatus)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, tenderId)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// Tenders provides a mock function with given fields: ctx, limit, offset, services
-func (_m *TenderStorage) Tenders(ctx context.Context, limit int32, offset int32, services []models.ServiceType) ([]models.Tender, error) {
-	ret := _m.Called(ctx, limit, offset, services)
+// Tenders provides a mock function with given fields: ctx, limit, offset, after, filter
+func (_m *TenderStorage) Tenders(ctx context.Context, limit int32, offset int32, after *models.Cursor, filter models.TenderFilter) ([]models.Tender, *models.Cursor, error) {
+	ret := _m.Called(ctx, limit, offset, after, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Tenders")
 	}
 
 	var r0 []models.Tender
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, []models.ServiceType) ([]models.Tender, error)); ok {
-		return rf(ctx, limit, offset, services)
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) ([]models.Tender, *models.Cursor, error)); ok {
+		return rf(ctx, limit, offset, after, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, []models.ServiceType) []models.Tender); ok {
-		r0 = rf(ctx, limit, offset, services)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) []models.Tender); ok {
+		r0 = rf(ctx, limit, offset, after, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Tender)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, []models.ServiceType) error); ok {
-		r1 = rf(ctx, limit, offset, services)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) *models.Cursor); ok {
+		r1 = rf(ctx, limit, offset, after, filter)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, int32, int32, *models.Cursor, models.TenderFilter) error); ok {
+		r2 = rf(ctx, limit, offset, after, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
-// UpdateTender provides a mock function with given fields: ctx, _a1
-func (_m *TenderStorage) UpdateTender(ctx context.Context, _a1 models.Tender) error {
-	ret := _m.Called(ctx, _a1)
+// UpdateTender provides a mock function with given fields: ctx, _a1, version
+func (_m *TenderStorage) UpdateTender(ctx context.Context, _a1 models.Tender, version int32) error {
+	ret := _m.Called(ctx, _a1, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateTender")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Tender) error); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, models.Tender, int32) error); ok {
+		r0 = rf(ctx, _a1, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -242,34 +253,43 @@ func (_m *TenderStorage) UpdateTender(ctx context.Context, _a1 models.Tender) er
 	return r0
 }
 
-// UserTenders provides a mock function with given fields: ctx, limit, offset, username
-func (_m *TenderStorage) UserTenders(ctx context.Context, limit int32, offset int32, username string) ([]models.Tender, error) {
-	ret := _m.Called(ctx, limit, offset, username)
+// UserTenders provides a mock function with given fields: ctx, limit, offset, after, username, statuses
+func (_m *TenderStorage) UserTenders(ctx context.Context, limit int32, offset int32, after *models.Cursor, username string, statuses []models.TenderStatus) ([]models.Tender, *models.Cursor, error) {
+	ret := _m.Called(ctx, limit, offset, after, username, statuses)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UserTenders")
 	}
 
 	var r0 []models.Tender
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, string) ([]models.Tender, error)); ok {
-		return rf(ctx, limit, offset, username)
+	var r1 *models.Cursor
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, string, []models.TenderStatus) ([]models.Tender, *models.Cursor, error)); ok {
+		return rf(ctx, limit, offset, after, username, statuses)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, string) []models.Tender); ok {
-		r0 = rf(ctx, limit, offset, username)
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32, *models.Cursor, string, []models.TenderStatus) []models.Tender); ok {
+		r0 = rf(ctx, limit, offset, after, username, statuses)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Tender)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, string) error); ok {
-		r1 = rf(ctx, limit, offset, username)
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32, *models.Cursor, string, []models.TenderStatus) *models.Cursor); ok {
+		r1 = rf(ctx, limit, offset, after, username, statuses)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*models.Cursor)
+		}
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, int32, int32, *models.Cursor, string, []models.TenderStatus) error); ok {
+		r2 = rf(ctx, limit, offset, after, username, statuses)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // NewTenderStorage creates a new instance of TenderStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
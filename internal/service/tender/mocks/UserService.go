@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	models "tender/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -15,35 +16,45 @@ type UserService struct {
 	mock.Mock
 }
 
-// Permission provides a mock function with given fields: ctx, username, orgId
-func (_m *UserService) Permission(ctx context.Context, username string, orgId uuid.UUID) error {
-	ret := _m.Called(ctx, username, orgId)
+// Caller provides a mock function with given fields: ctx
+func (_m *UserService) Caller(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Permission")
+		panic("no return value specified for Caller")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
-		r0 = rf(ctx, username, orgId)
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Validate provides a mock function with given fields: ctx, username
-func (_m *UserService) Validate(ctx context.Context, username string) error {
-	ret := _m.Called(ctx, username)
+// Permission provides a mock function with given fields: ctx, username, orgId, action
+func (_m *UserService) Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error {
+	ret := _m.Called(ctx, username, orgId, action)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Validate")
+		panic("no return value specified for Permission")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, username)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, models.Action) error); ok {
+		r0 = rf(ctx, username, orgId, action)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -19,12 +19,13 @@ type Tender struct {
 	tenderStorage TenderStorage
 	userSrv       UserService
 	rollbackSrv   RollbackService
+	transitions   models.TenderTransitions
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name UserService
 type UserService interface {
-	Validate(ctx context.Context, username string) error
-	Permission(ctx context.Context, username string, orgId uuid.UUID) error
+	Caller(ctx context.Context) (string, error)
+	Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name RollbackService
@@ -32,6 +33,8 @@ type RollbackService interface {
 	SaveTender(ctx context.Context, tender models.Tender) error
 	// Save outdated tender and recover old tender.
 	SwapTender(ctx context.Context, tenderId uuid.UUID, version int32, outdatedTedner models.Tender) (models.Tender, error)
+	TenderVersions(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.Tender, error)
+	TenderVersion(ctx context.Context, tenderId uuid.UUID, version int32) (models.Tender, error)
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name TenderStorage
@@ -42,10 +45,11 @@ type TenderStorage interface {
 
 	InsertTender(ctx context.Context, tender models.Tender) (models.Tender, error)
 	Tender(ctx context.Context, id uuid.UUID) (models.Tender, error)
-	UpdateTender(ctx context.Context, tender models.Tender) error
-	Tenders(ctx context.Context, limit, offset int32, services []models.ServiceType) ([]models.Tender, error)
-	UserTenders(ctx context.Context, limit, offset int32, username string) ([]models.Tender, error)
+	UpdateTender(ctx context.Context, tender models.Tender, version int32) error
+	Tenders(ctx context.Context, limit, offset int32, after *models.Cursor, filter models.TenderFilter) ([]models.Tender, *models.Cursor, error)
+	UserTenders(ctx context.Context, limit, offset int32, after *models.Cursor, username string, statuses []models.TenderStatus) ([]models.Tender, *models.Cursor, error)
 	TenderSetStatus(ctx context.Context, tenderId uuid.UUID, status models.TenderStatus) (models.Tender, error)
+	CloseOverdueTenders(ctx context.Context) ([]models.Tender, error)
 }
 
 func New(
@@ -53,12 +57,14 @@ func New(
 	userSrv UserService,
 	rollback RollbackService,
 	tenderStorage TenderStorage,
+	transitions models.TenderTransitions,
 ) *Tender {
 	return &Tender{
 		log:           log,
 		tenderStorage: tenderStorage,
 		userSrv:       userSrv,
 		rollbackSrv:   rollback,
+		transitions:   transitions,
 	}
 }
 
@@ -68,7 +74,7 @@ func (t *Tender) New(ctx context.Context, tenderNew models.TenderNew) (models.Te
 
 	log := t.log.With(
 		slog.String("op", op),
-		slog.String("username", tenderNew.CreatorUsername),
+		slog.String("creator", tenderNew.CreatorUsername),
 	)
 
 	ctx, err := t.tenderStorage.Begin(ctx)
@@ -82,13 +88,30 @@ func (t *Tender) New(ctx context.Context, tenderNew models.TenderNew) (models.Te
 		}
 	}()
 
-	// Check if user exists
-	if err := t.userSrv.Validate(ctx, tenderNew.CreatorUsername); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.TenderOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Tender can be created only on behalf of caller.
+	if username != tenderNew.CreatorUsername {
+		log.Warn("creator is not caller", slog.String("username", username))
+		return models.TenderOut{}, service.ErrNotEnoughPrivileges
+	}
+
+	// Check if user is allowed to create organization's tenders.
+	if err := t.userSrv.Permission(ctx, username, tenderNew.OrgId, models.ActionEdit); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("unallowed to create tender")
 			return models.TenderOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to check user permission", sl.Err(err))
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
@@ -106,19 +129,25 @@ func (t *Tender) New(ctx context.Context, tenderNew models.TenderNew) (models.Te
 		log.Error("failed to commit", sl.Err(err))
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
-	return tender.ToOut(), nil
+	return t.out(tender), nil
 }
 
-// All returns all tenders.
-func (t *Tender) All(ctx context.Context, limit, offset int32, services []models.ServiceType) ([]models.TenderOut, error) {
+// All returns tenders matching filter.
+// Tenders in statuses other than published are returned only
+// to responsibles of organization set in filter.
+// Returns cursor of the next page if there is one.
+func (t *Tender) All(ctx context.Context, limit, offset int32, after *models.Cursor, filter models.TenderFilter) ([]models.TenderOut, *models.Cursor, error) {
 	const op = "Tender.All"
 
-	log := t.log.With(slog.String("op", op))
+	log := t.log.With(
+		slog.String("op", op),
+		slog.Any("filter", filter),
+	)
 
 	ctx, err := t.tenderStorage.Begin(ctx)
 	if err != nil {
 		log.Error("failed to start tx", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer func() {
 		if err := t.tenderStorage.Rollback(ctx); err != nil {
@@ -126,42 +155,73 @@ func (t *Tender) All(ctx context.Context, limit, offset int32, services []models
 		}
 	}()
 
-	// Get all tenders.
-	res, err := t.tenderStorage.Tenders(ctx, limit, offset, services)
+	// Check if user is allowed to view not published tenders.
+	if !filter.Public() {
+		if filter.OrgId == nil {
+			log.Warn("status filter without organization")
+			return nil, nil, &service.PermissionError{Action: models.ActionView}
+		}
+
+		// Get caller.
+		username, err := t.userSrv.Caller(ctx)
+		if err != nil {
+			if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+				log.Warn("caller not found")
+				return nil, nil, err
+			}
+			log.Error("failed to get caller", sl.Err(err))
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		log = log.With(slog.String("username", username))
+
+		if err := t.userSrv.Permission(ctx, username, *filter.OrgId, models.ActionView); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				log.Warn("unallowed to view")
+				return nil, nil, err
+			}
+			log.Error("failed to check user permission", sl.Err(err))
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	// Get tenders.
+	res, next, err := t.tenderStorage.Tenders(ctx, limit, offset, after, filter)
 	if err != nil {
-		log.Error("failed to get tenders", slog.Int("limit", int(limit)), slog.Int("offset", int(offset)), slog.Any("services", services), sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		log.Error("failed to get tenders", slog.Int("limit", int(limit)), slog.Int("offset", int(offset)), sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Convert slice elements.
 	out := make([]models.TenderOut, 0, len(res))
 	for i := range res {
-		out = append(out, res[i].ToOut())
+		out = append(out, t.out(res[i]))
 	}
 
 	if err := t.tenderStorage.Commit(ctx); err != nil {
 		log.Error("failed to commit", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return out, err
+	return out, next, nil
 }
 
-// My returns user's tenders.
-func (t *Tender) My(ctx context.Context, limit, offset int32, username string) ([]models.TenderOut, error) {
+// My returns tenders of all organizations user is responsible for.
+// If statuses are given, only tenders in one of them are returned.
+// Returns cursor of the next page if there is one.
+func (t *Tender) My(ctx context.Context, limit, offset int32, after *models.Cursor, statuses []models.TenderStatus) ([]models.TenderOut, *models.Cursor, error) {
 	const op = "Tender.My"
 
 	log := t.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.Int("limit", int(limit)),
 		slog.Int("offset", int(offset)),
+		slog.Any("statuses", statuses),
 	)
 
 	ctx, err := t.tenderStorage.Begin(ctx)
 	if err != nil {
 		log.Error("failed to start tx", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer func() {
 		if err := t.tenderStorage.Rollback(ctx); err != nil {
@@ -169,44 +229,109 @@ func (t *Tender) My(ctx context.Context, limit, offset int32, username string) (
 		}
 	}()
 
-	// Check if user exists
-	if err := t.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
-			return nil, err
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, nil, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get user's tenders.
-	res, err := t.tenderStorage.UserTenders(ctx, limit, offset, username)
+	res, next, err := t.tenderStorage.UserTenders(ctx, limit, offset, after, username, statuses)
 	if err != nil {
 		log.Error("failed to get tenders", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	// Convert slice elements.
 	out := make([]models.TenderOut, 0, len(res))
 	for i := range res {
-		out = append(out, res[i].ToOut())
+		out = append(out, t.out(res[i]))
 	}
 
 	if err := t.tenderStorage.Commit(ctx); err != nil {
 		log.Error("failed to commit", sl.Err(err))
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return out, nil
+	return out, next, nil
+}
+
+// Get returns tender by its id.
+// Tender that is not published is shown only to organization responsibles.
+func (t *Tender) Get(ctx context.Context, tenderId uuid.UUID) (models.TenderOut, error) {
+	const op = "Tender.Get"
+
+	log := t.log.With(
+		slog.String("op", op),
+		slog.String("id", tenderId.String()),
+	)
+
+	ctx, err := t.tenderStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := t.tenderStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.TenderOut{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender
+	tender, err := t.tenderStorage.Tender(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, storage.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.TenderOut{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Drafts and closed tenders are visible only to responsibles.
+	if tender.Status != models.TenderPublished {
+		if err := t.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+			if errors.Is(err, service.ErrNotEnoughPrivileges) {
+				log.Warn("unallowed to view", slog.String("status", string(tender.Status)))
+				return models.TenderOut{}, err
+			}
+			log.Error("failed to check user permission", sl.Err(err))
+			return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := t.tenderStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return t.out(tender), nil
 }
 
 // TenderStatus returns tender status.
-func (t *Tender) Status(ctx context.Context, username string, tenderId uuid.UUID) (models.TenderStatus, error) {
+func (t *Tender) Status(ctx context.Context, tenderId uuid.UUID) (models.TenderStatus, error) {
 	const op = "Tender.TenderStatus"
 
 	log := t.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("id", tenderId.String()),
 	)
 
@@ -221,15 +346,17 @@ func (t *Tender) Status(ctx context.Context, username string, tenderId uuid.UUID
 		}
 	}()
 
-	// Check if user exists
-	if err := t.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return "", err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get tender
 	tender, err := t.tenderStorage.Tender(ctx, tenderId)
@@ -242,10 +369,10 @@ func (t *Tender) Status(ctx context.Context, username string, tenderId uuid.UUID
 		return "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	if err := t.userSrv.Permission(ctx, username, tender.OrgId); err != nil {
+	if err := t.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
 			log.Warn("unallowed to modify")
-			return "", service.ErrNotEnoughPrivileges
+			return "", err
 		}
 		log.Error("failed to check user permission")
 		return "", fmt.Errorf("%s: %w", op, err)
@@ -261,12 +388,11 @@ func (t *Tender) Status(ctx context.Context, username string, tenderId uuid.UUID
 
 // TenderSetStatus updates tender status.
 // If it is not allowed for user returns error.
-func (t *Tender) SetStatus(ctx context.Context, username string, tenderId uuid.UUID, status models.TenderStatus) (models.TenderOut, error) {
+func (t *Tender) SetStatus(ctx context.Context, tenderId uuid.UUID, status models.TenderStatus) (models.TenderOut, error) {
 	const op = "Tender.TenderSetStatus"
 
 	log := t.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("id", tenderId.String()),
 		slog.String("new status", string(status)),
 	)
@@ -282,15 +408,17 @@ func (t *Tender) SetStatus(ctx context.Context, username string, tenderId uuid.U
 		}
 	}()
 
-	// Check if user exists
-	if err := t.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return models.TenderOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get tender.
 	tender, err := t.tenderStorage.Tender(ctx, tenderId)
@@ -304,15 +432,21 @@ func (t *Tender) SetStatus(ctx context.Context, username string, tenderId uuid.U
 	}
 
 	// Check if user is allowed to modify tender.
-	if err := t.userSrv.Permission(ctx, username, tender.OrgId); err != nil {
+	if err := t.userSrv.Permission(ctx, username, tender.OrgId, statusAction(status)); err != nil {
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
 			log.Warn("unallowed to modify")
-			return models.TenderOut{}, service.ErrNotEnoughPrivileges
+			return models.TenderOut{}, err
 		}
 		log.Error("failed to check user permission")
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Check if status can be changed.
+	if !t.transitions.Allowed(tender.Status, status) {
+		log.Warn("invalid status transition", slog.String("current status", string(tender.Status)))
+		return models.TenderOut{}, service.ErrInvalidTransition
+	}
+
 	// Update tender status.
 	tender, err = t.tenderStorage.TenderSetStatus(ctx, tenderId, status)
 	if err != nil {
@@ -329,18 +463,20 @@ func (t *Tender) SetStatus(ctx context.Context, username string, tenderId uuid.U
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return tender.ToOut(), nil
+	return t.out(tender), nil
 }
 
 // Edit updates tender.
 // If it is not allowed for user returns error.
-func (t *Tender) Edit(ctx context.Context, username string, tenderId uuid.UUID, patch models.TenderPatch) (models.TenderOut, error) {
+// If version is not zero, tender is edited only if its current version equals to it,
+// otherwise current tender is returned with ErrVersionConflict.
+func (t *Tender) Edit(ctx context.Context, tenderId uuid.UUID, version int32, patch models.TenderPatch) (models.TenderOut, error) {
 	const op = "Tender.Edit"
 
 	log := t.log.With(
 		slog.String("op", op),
-		slog.String("username", username),
 		slog.String("id", tenderId.String()),
+		slog.Int("version", int(version)),
 	)
 
 	ctx, err := t.tenderStorage.Begin(ctx)
@@ -354,15 +490,17 @@ func (t *Tender) Edit(ctx context.Context, username string, tenderId uuid.UUID,
 		}
 	}()
 
-	// Check if user exists
-	if err := t.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return models.TenderOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get tender.
 	tender, err := t.tenderStorage.Tender(ctx, tenderId)
@@ -376,26 +514,41 @@ func (t *Tender) Edit(ctx context.Context, username string, tenderId uuid.UUID,
 	}
 
 	// Check if user is allowed to modify tender.
-	if err := t.userSrv.Permission(ctx, username, tender.OrgId); err != nil {
+	if err := t.userSrv.Permission(ctx, username, tender.OrgId, models.ActionEdit); err != nil {
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
 			log.Warn("unallowed to modify")
-			return models.TenderOut{}, service.ErrNotEnoughPrivileges
+			return models.TenderOut{}, err
 		}
 		log.Error("failed to check user permission", sl.Err(err))
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Check if tender was not modified since expected version.
+	if version != 0 && tender.Version != version {
+		log.Warn("version conflict", slog.Int("current version", int(tender.Version)))
+		return t.out(tender), service.ErrVersionConflict
+	}
+
 	// Apply tender.
 	newTender := tender
 	newTender.Patch(patch)
 	newTender.Version += 1
 
 	// Update tender.
-	if err := t.tenderStorage.UpdateTender(ctx, newTender); err != nil {
+	if err := t.tenderStorage.UpdateTender(ctx, newTender, tender.Version); err != nil {
 		if errors.Is(err, storage.ErrTenderNotFound) {
 			log.Warn("tender not found")
 			return models.TenderOut{}, service.ErrTenderNotFound
 		}
+		if errors.Is(err, storage.ErrVersionConflict) {
+			log.Warn("tender was modified concurrently")
+			current, err := t.tenderStorage.Tender(ctx, tenderId)
+			if err != nil {
+				log.Error("failed to get tender", sl.Err(err))
+				return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
+			}
+			return t.out(current), service.ErrVersionConflict
+		}
 		log.Error("failed to updated tender", sl.Err(err))
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
@@ -411,17 +564,16 @@ func (t *Tender) Edit(ctx context.Context, username string, tenderId uuid.UUID,
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return newTender.ToOut(), nil
+	return t.out(newTender), nil
 }
 
 // Rollback restores old tender version.
 // If version doesn't exist returns error.
-func (t *Tender) Rollback(ctx context.Context, username string, id uuid.UUID, version int32) (models.TenderOut, error) {
+func (t *Tender) Rollback(ctx context.Context, id uuid.UUID, version int32) (models.TenderOut, error) {
 	const op = "Tender.Rollback"
 
 	log := t.log.With(
 		slog.String("op", op),
-		slog.String("user", username),
 		slog.String("id", id.String()),
 		slog.Int("version", int(version)),
 	)
@@ -437,15 +589,17 @@ func (t *Tender) Rollback(ctx context.Context, username string, id uuid.UUID, ve
 		}
 	}()
 
-	// Check if user exists
-	if err := t.userSrv.Validate(ctx, username); err != nil {
-		if errors.Is(err, service.ErrUserNotFound) {
-			log.Warn("user not found")
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
 			return models.TenderOut{}, err
 		}
-		log.Error("failed to verify user", sl.Err(err))
+		log.Error("failed to get caller", sl.Err(err))
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
+	log = log.With(slog.String("username", username))
 
 	// Get actual tender.
 	tender, err := t.tenderStorage.Tender(ctx, id)
@@ -459,10 +613,10 @@ func (t *Tender) Rollback(ctx context.Context, username string, id uuid.UUID, ve
 	}
 
 	// Check if user is allowed to modify tender.
-	if err := t.userSrv.Permission(ctx, username, tender.OrgId); err != nil {
+	if err := t.userSrv.Permission(ctx, username, tender.OrgId, models.ActionEdit); err != nil {
 		if errors.Is(err, service.ErrNotEnoughPrivileges) {
 			log.Warn("unallowed to modify")
-			return models.TenderOut{}, service.ErrNotEnoughPrivileges
+			return models.TenderOut{}, err
 		}
 		log.Error("failed to check user permission")
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
@@ -493,7 +647,7 @@ func (t *Tender) Rollback(ctx context.Context, username string, id uuid.UUID, ve
 		return models.TenderOut{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return newTender.ToOut(), nil
+	return t.out(newTender), nil
 }
 
 // Tender return tender by its id.
@@ -533,3 +687,282 @@ func (t *Tender) Tender(ctx context.Context, tenderId uuid.UUID) (models.Tender,
 
 	return res, nil
 }
+
+// Close closes tender when its bid is approved.
+// Caller's permission is checked by the deciding service,
+// status transition is checked here.
+func (t *Tender) Close(ctx context.Context, tenderId uuid.UUID) (models.Tender, error) {
+	const op = "Tender.Close"
+
+	log := t.log.With(
+		slog.String("op", op),
+		slog.String("id", tenderId.String()),
+	)
+
+	ctx, err := t.tenderStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := t.tenderStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get tender.
+	tender, err := t.tenderStorage.Tender(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, storage.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.Tender{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if tender can be closed.
+	if !t.transitions.Allowed(tender.Status, models.TenderClosed) {
+		log.Warn("invalid status transition", slog.String("current status", string(tender.Status)))
+		return models.Tender{}, service.ErrInvalidTransition
+	}
+
+	// Update tender status.
+	tender, err = t.tenderStorage.TenderSetStatus(ctx, tenderId, models.TenderClosed)
+	if err != nil {
+		if errors.Is(err, storage.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.Tender{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to close tender", sl.Err(err))
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.tenderStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.Tender{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return tender, nil
+}
+
+// CloseOverdue closes published tenders whose deadline has passed.
+// Returns # of closed tenders.
+func (t *Tender) CloseOverdue(ctx context.Context) (int, error) {
+	const op = "Tender.CloseOverdue"
+
+	log := t.log.With(slog.String("op", op))
+
+	ctx, err := t.tenderStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := t.tenderStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Close overdue tenders.
+	closed, err := t.tenderStorage.CloseOverdueTenders(ctx)
+	if err != nil {
+		log.Error("failed to close overdue tenders", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.tenderStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for i := range closed {
+		log.Info("tender closed by deadline", slog.String("id", closed[i].Id.String()))
+	}
+
+	return len(closed), nil
+}
+
+// Versions returns outdated versions of tender available for rollback.
+func (t *Tender) Versions(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.TenderOut, error) {
+	const op = "Tender.Versions"
+
+	log := t.log.With(
+		slog.String("op", op),
+		slog.String("id", tenderId.String()),
+		slog.Int("limit", int(limit)),
+		slog.Int("offset", int(offset)),
+	)
+
+	ctx, err := t.tenderStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := t.tenderStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return nil, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := t.tenderStorage.Tender(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, storage.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return nil, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user is allowed to see tender history.
+	if err := t.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("unallowed to see versions")
+			return nil, err
+		}
+		log.Error("failed to check user permission", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get outdated versions.
+	res, err := t.rollbackSrv.TenderVersions(ctx, tenderId, limit, offset)
+	if err != nil {
+		log.Error("failed to get versions", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Convert slice elements.
+	out := make([]models.TenderOut, 0, len(res))
+	for i := range res {
+		out = append(out, res[i].ToOut())
+	}
+
+	if err := t.tenderStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return out, nil
+}
+
+// Diff returns changes between two versions of tender.
+func (t *Tender) Diff(ctx context.Context, tenderId uuid.UUID, fromVersion, toVersion int32) (models.Diff, error) {
+	const op = "Tender.Diff"
+
+	log := t.log.With(
+		slog.String("op", op),
+		slog.String("id", tenderId.String()),
+		slog.Int("from", int(fromVersion)),
+		slog.Int("to", int(toVersion)),
+	)
+
+	ctx, err := t.tenderStorage.Begin(ctx)
+	if err != nil {
+		log.Error("failed to start tx", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := t.tenderStorage.Rollback(ctx); err != nil {
+			log.Error("failed to rollback", sl.Err(err))
+		}
+	}()
+
+	// Get caller.
+	username, err := t.userSrv.Caller(ctx)
+	if err != nil {
+		if errors.Is(err, service.ErrUnauthorized) || errors.Is(err, service.ErrUserNotFound) {
+			log.Warn("caller not found")
+			return models.Diff{}, err
+		}
+		log.Error("failed to get caller", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+	log = log.With(slog.String("username", username))
+
+	// Get tender.
+	tender, err := t.tenderStorage.Tender(ctx, tenderId)
+	if err != nil {
+		if errors.Is(err, storage.ErrTenderNotFound) {
+			log.Warn("tender not found")
+			return models.Diff{}, service.ErrTenderNotFound
+		}
+		log.Error("failed to get tender", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Check if user is allowed to see tender history.
+	if err := t.userSrv.Permission(ctx, username, tender.OrgId, models.ActionView); err != nil {
+		if errors.Is(err, service.ErrNotEnoughPrivileges) {
+			log.Warn("unallowed to see versions")
+			return models.Diff{}, err
+		}
+		log.Error("failed to check user permission", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Get compared versions.
+	from, err := t.version(ctx, tender, fromVersion)
+	if err != nil {
+		if errors.Is(err, service.ErrVersionNotFound) {
+			log.Warn("from version not found")
+			return models.Diff{}, service.ErrVersionNotFound
+		}
+		log.Error("failed to get from version", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+	to, err := t.version(ctx, tender, toVersion)
+	if err != nil {
+		if errors.Is(err, service.ErrVersionNotFound) {
+			log.Warn("to version not found")
+			return models.Diff{}, service.ErrVersionNotFound
+		}
+		log.Error("failed to get to version", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := t.tenderStorage.Commit(ctx); err != nil {
+		log.Error("failed to commit", sl.Err(err))
+		return models.Diff{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return models.TenderDiff(from, to), nil
+}
+
+// version returns tender of given version.
+// Actual tender is returned as is, outdated one is taken from rollback.
+func (t *Tender) version(ctx context.Context, tender models.Tender, version int32) (models.Tender, error) {
+	if version == tender.Version {
+		return tender, nil
+	}
+	return t.rollbackSrv.TenderVersion(ctx, tender.Id, version)
+}
+
+// out converts tender to output model
+// with statuses allowed to be set next.
+func (t *Tender) out(tender models.Tender) models.TenderOut {
+	out := tender.ToOut()
+	out.NextStatuses = t.transitions.Next(tender.Status)
+	return out
+}
+
+// statusAction returns action required to set tender status.
+func statusAction(status models.TenderStatus) models.Action {
+	if status == models.TenderClosed {
+		return models.ActionClose
+	}
+	return models.ActionPublish
+}
@@ -36,6 +36,9 @@ func TestNewTender(t *testing.T) {
 	type validateRes struct {
 		err error
 	}
+	type permissionRes struct {
+		err error
+	}
 	type insertTenderRes struct {
 		tender models.Tender
 		err    error
@@ -43,8 +46,10 @@ func TestNewTender(t *testing.T) {
 	tests := []struct {
 		name            string
 		args            args
+		caller          string
 		want            want
 		validateRes     *validateRes
+		permissionRes   *permissionRes
 		insertTenderRes *insertTenderRes
 	}{
 		{
@@ -63,7 +68,8 @@ func TestNewTender(t *testing.T) {
 					OrgId: ORG_UUID,
 				},
 			}, nil},
-			validateRes: &validateRes{nil},
+			validateRes:   &validateRes{nil},
+			permissionRes: &permissionRes{nil},
 			insertTenderRes: &insertTenderRes{models.Tender{
 				Version:   1,
 				CreatedAt: time.Unix(10000, 0),
@@ -79,19 +85,53 @@ func TestNewTender(t *testing.T) {
 			want:        want{models.TenderOut{}, service.ErrUserNotFound},
 			validateRes: &validateRes{service.ErrUserNotFound},
 		},
+		{
+			name: "no permissions",
+			args: args{context.Background(), models.TenderNew{
+				CreatorUsername: "user",
+				TenderBase: models.TenderBase{
+					OrgId: ORG_UUID,
+				},
+			}},
+			want:          want{models.TenderOut{}, &service.PermissionError{Action: models.ActionEdit}},
+			validateRes:   &validateRes{nil},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionEdit}},
+		},
+		{
+			name: "creator is not caller",
+			args: args{context.Background(), models.TenderNew{
+				CreatorUsername: "user",
+				TenderBase: models.TenderBase{
+					OrgId: ORG_UUID,
+				},
+			}},
+			caller:      "intruder",
+			want:        want{models.TenderOut{}, service.ErrNotEnoughPrivileges},
+			validateRes: &validateRes{nil},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			user := mocks.NewUserService(t)
 			tStorage := mocks.NewTenderStorage(t)
 
+			caller := tt.args.tenderNew.CreatorUsername
+			if tt.caller != "" {
+				caller = tt.caller
+			}
+
 			tStorage.
 				On("Begin", tt.args.ctx).
 				Return(tt.args.ctx, nil)
 			if tt.validateRes != nil {
 				user.
-					On("Validate", mock.Anything, tt.args.tenderNew.CreatorUsername).
-					Return(tt.validateRes.err)
+					On("Caller", mock.Anything).
+					Return(caller, tt.validateRes.err)
+				if tt.permissionRes != nil {
+					user.
+						On("Permission", mock.Anything, tt.args.tenderNew.CreatorUsername, tt.args.tenderNew.OrgId, models.ActionEdit).
+						Return(tt.permissionRes.err)
+				}
 				if tt.insertTenderRes != nil {
 					tStorage.
 						On("InsertTender", mock.Anything, mock.Anything).
@@ -129,45 +169,215 @@ func TestNewTender(t *testing.T) {
 func TestAll(t *testing.T) {
 	type args struct {
 		ctx           context.Context
+		username      string
 		limit, offset int32
-		serviceType   []models.ServiceType
+		after         *models.Cursor
+		filter        models.TenderFilter
 	}
 	type want struct {
 		tender []models.TenderOut
+		next   *models.Cursor
 		err    error
 	}
 	type tendersRes struct {
 		tenders []models.Tender
+		next    *models.Cursor
 		err     error
 	}
+	type permissionRes struct {
+		err error
+	}
 	tests := []struct {
-		name       string
-		args       args
-		want       want
-		tendersRes tendersRes
+		name          string
+		args          args
+		want          want
+		permissionRes *permissionRes
+		tendersRes    *tendersRes
 	}{
 		{
 			name: "main line",
 			args: args{limit: 3},
 			want: want{[]models.TenderOut{
 				{Id: ID_UUID, Version: 3, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID}},
-			}, nil},
-			tendersRes: tendersRes{[]models.Tender{
+			}, nil, nil},
+			tendersRes: &tendersRes{[]models.Tender{
 				{Id: ID_UUID, Version: 3, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID}},
-			}, nil},
+			}, nil, nil},
+		},
+		{
+			name: "search",
+			args: args{limit: 3, filter: models.TenderFilter{Query: "road repair"}},
+			want: want{[]models.TenderOut{
+				{Id: ID_UUID2, Version: 1, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID, Name: "Road repair"}},
+			}, nil, nil},
+			tendersRes: &tendersRes{[]models.Tender{
+				{Id: ID_UUID2, Version: 1, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID, Name: "Road repair"}},
+			}, nil, nil},
+		},
+		{
+			name: "next page",
+			args: args{limit: 1, after: &models.Cursor{Name: "A", Id: ID_UUID}},
+			want: want{[]models.TenderOut{
+				{Id: ID_UUID2, Version: 1, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID, Name: "B"}},
+			}, &models.Cursor{Name: "B", Id: ID_UUID2}, nil},
+			tendersRes: &tendersRes{[]models.Tender{
+				{Id: ID_UUID2, Version: 1, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID, Name: "B"}},
+			}, &models.Cursor{Name: "B", Id: ID_UUID2}, nil},
+		},
+		{
+			name: "closed tenders of responsible's organization",
+			args: args{username: "user", limit: 3, filter: models.TenderFilter{
+				OrgId:    ptr.Ptr(ORG_UUID),
+				Statuses: []models.TenderStatus{models.TenderClosed},
+				Sort:     models.SortByCreatedAtDesc,
+			}},
+			want: want{[]models.TenderOut{
+				{Id: ID_UUID, Version: 1, Status: models.TenderClosed, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID}},
+			}, nil, nil},
+			permissionRes: &permissionRes{nil},
+			tendersRes: &tendersRes{[]models.Tender{
+				{Id: ID_UUID, Version: 1, Status: models.TenderClosed, CreatedAt: time.Unix(0, 0), TenderBase: models.TenderBase{OrgId: ORG_UUID}},
+			}, nil, nil},
+		},
+		{
+			name: "closed tenders of other organization",
+			args: args{username: "user", limit: 3, filter: models.TenderFilter{
+				OrgId:    ptr.Ptr(ORG_UUID),
+				Statuses: []models.TenderStatus{models.TenderClosed},
+			}},
+			want:          want{nil, nil, service.ErrNotEnoughPrivileges},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+		},
+		{
+			name: "closed tenders without organization",
+			args: args{username: "user", limit: 3, filter: models.TenderFilter{
+				Statuses: []models.TenderStatus{models.TenderPublished, models.TenderCreated},
+			}},
+			want: want{nil, nil, service.ErrNotEnoughPrivileges},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
 			tStorage := mocks.NewTenderStorage(t)
 
 			tStorage.
 				On("Begin", tt.args.ctx).
 				Return(tt.args.ctx, nil)
+			if tt.permissionRes != nil {
+				user.
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, nil)
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, *tt.args.filter.OrgId, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.tendersRes != nil {
+				tStorage.
+					On("Tenders", tt.args.ctx, tt.args.limit, tt.args.offset, tt.args.after, tt.args.filter).
+					Return(tt.tendersRes.tenders, tt.tendersRes.next, tt.tendersRes.err)
+			}
+			if tt.want.err == nil {
+				tStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
 			tStorage.
-				On("Tenders", tt.args.ctx, tt.args.limit, tt.args.offset, tt.args.serviceType).
-				Return(tt.tendersRes.tenders, tt.tendersRes.err)
-			if tt.tendersRes.err == nil {
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			tender := Tender{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:       user,
+				tenderStorage: tStorage,
+			}
+
+			res, next, err := tender.All(tt.args.ctx, tt.args.limit, tt.args.offset, tt.args.after, tt.args.filter)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.tender, res)
+				assert.Equal(t, tt.want.next, next)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		id       uuid.UUID
+	}
+	type want struct {
+		tender models.TenderOut
+		err    error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
+	type permissionRes struct {
+		err error
+	}
+	published := models.Tender{Id: ID_UUID, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}}
+	closed := models.Tender{Id: ID_UUID, Status: models.TenderClosed, TenderBase: models.TenderBase{OrgId: ORG_UUID}}
+	tests := []struct {
+		name          string
+		args          args
+		tenderRes     tenderRes
+		permissionRes *permissionRes
+		want          want
+	}{
+		{
+			name:      "published tender",
+			args:      args{context.Background(), "user", ID_UUID},
+			tenderRes: tenderRes{published, nil},
+			want:      want{published.ToOut(), nil},
+		},
+		{
+			name:          "closed tender seen by responsible",
+			args:          args{context.Background(), "responsible", ID_UUID},
+			tenderRes:     tenderRes{closed, nil},
+			permissionRes: &permissionRes{nil},
+			want:          want{closed.ToOut(), nil},
+		},
+		{
+			name:          "closed tender hidden from user",
+			args:          args{context.Background(), "user", ID_UUID},
+			tenderRes:     tenderRes{closed, nil},
+			permissionRes: &permissionRes{&service.PermissionError{Action: models.ActionView}},
+			want:          want{models.TenderOut{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name:      "tender not found",
+			args:      args{context.Background(), "user", ID_UUID},
+			tenderRes: tenderRes{models.Tender{}, storage.ErrTenderNotFound},
+			want:      want{models.TenderOut{}, service.ErrTenderNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tStorage := mocks.NewTenderStorage(t)
+
+			tStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			tStorage.
+				On("Tender", tt.args.ctx, tt.args.id).
+				Return(tt.tenderRes.tender, tt.tenderRes.err)
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, ORG_UUID, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.want.err == nil {
 				tStorage.
 					On("Commit", tt.args.ctx).
 					Return(nil)
@@ -179,16 +389,16 @@ func TestAll(t *testing.T) {
 			tender := Tender{
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
-				userSrv:       nil,
+				userSrv:       user,
 				tenderStorage: tStorage,
 			}
 
-			res, err := tender.All(tt.args.ctx, tt.args.limit, tt.args.offset, tt.args.serviceType)
+			res, err := tender.Get(tt.args.ctx, tt.args.id)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want.tender, res)
 			} else {
-				assert.EqualError(t, err, tt.want.err.Error())
+				assert.ErrorIs(t, err, tt.want.err)
 			}
 		})
 	}
@@ -230,13 +440,13 @@ func TestSetStatus(t *testing.T) {
 	}{
 		{
 			name:        "main line",
-			args:        args{username: "user", id: ID_UUID, status: models.TenderCreated},
+			args:        args{username: "user", id: ID_UUID, status: models.TenderPublished},
 			validateRes: &validateRes{nil},
 			tendersRes: &tenderRes{models.Tender{
 				Id:        ID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.TenderClosed,
+				Status:    models.TenderCreated,
 				TenderBase: models.TenderBase{
 					OrgId: ORG_UUID,
 				}}, nil},
@@ -245,19 +455,33 @@ func TestSetStatus(t *testing.T) {
 				Id:        ID_UUID,
 				Version:   2,
 				CreatedAt: time.Unix(10, 0),
-				Status:    models.TenderCreated,
+				Status:    models.TenderPublished,
 				TenderBase: models.TenderBase{
 					OrgId: ORG_UUID,
 				}}, nil},
 			want: want{models.TenderOut{
-				Id:        ID_UUID,
-				Version:   2,
-				CreatedAt: time.Unix(10, 0),
-				Status:    models.TenderCreated,
+				Id:           ID_UUID,
+				Version:      2,
+				CreatedAt:    time.Unix(10, 0),
+				Status:       models.TenderPublished,
+				NextStatuses: []models.TenderStatus{models.TenderClosed},
 				TenderBase: models.TenderBase{
 					OrgId: ORG_UUID,
 				}}, nil},
 		},
+		{
+			name:        "invalid transition",
+			args:        args{username: "user", id: ID_UUID, status: models.TenderCreated},
+			validateRes: &validateRes{nil},
+			tendersRes: &tenderRes{models.Tender{
+				Id:     ID_UUID,
+				Status: models.TenderClosed,
+				TenderBase: models.TenderBase{
+					OrgId: ORG_UUID,
+				}}, nil},
+			permissionRes: &permissionRes{nil},
+			want:          want{models.TenderOut{}, service.ErrInvalidTransition},
+		},
 		{
 			name:        "tender not found",
 			args:        args{username: "name", id: ID_UUID, status: models.TenderCreated},
@@ -284,8 +508,8 @@ func TestSetStatus(t *testing.T) {
 				Return(tt.args.ctx, nil)
 			if tt.validateRes != nil {
 				user.
-					On("Validate", tt.args.ctx, tt.args.username).
-					Return(tt.validateRes.err)
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, tt.validateRes.err)
 			}
 			if tt.tendersRes != nil {
 				tStorage.
@@ -294,7 +518,7 @@ func TestSetStatus(t *testing.T) {
 			}
 			if tt.permissionRes != nil {
 				user.
-					On("Permission", tt.args.ctx, tt.args.username, tt.tendersRes.tender.OrgId).
+					On("Permission", tt.args.ctx, tt.args.username, tt.tendersRes.tender.OrgId, statusAction(tt.args.status)).
 					Return(tt.permissionRes.err)
 			}
 			if tt.setStatusRes != nil {
@@ -316,9 +540,96 @@ func TestSetStatus(t *testing.T) {
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
 				userSrv:       user,
 				tenderStorage: tStorage,
+				transitions:   models.NewTenderTransitions(false),
 			}
 
-			res, err := tender.SetStatus(tt.args.ctx, tt.args.username, tt.args.id, tt.args.status)
+			res, err := tender.SetStatus(tt.args.ctx, tt.args.id, tt.args.status)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.tender, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
+
+func TestClose(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		id  uuid.UUID
+	}
+	type want struct {
+		tender models.Tender
+		err    error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
+	type setStatusRes struct {
+		tender models.Tender
+		err    error
+	}
+	tests := []struct {
+		name         string
+		args         args
+		tendersRes   *tenderRes
+		setStatusRes *setStatusRes
+		want         want
+	}{
+		{
+			name:         "main line",
+			args:         args{id: ID_UUID},
+			tendersRes:   &tenderRes{models.Tender{Id: ID_UUID, Status: models.TenderPublished}, nil},
+			setStatusRes: &setStatusRes{models.Tender{Id: ID_UUID, Status: models.TenderClosed}, nil},
+			want:         want{models.Tender{Id: ID_UUID, Status: models.TenderClosed}, nil},
+		},
+		{
+			name:       "invalid transition",
+			args:       args{id: ID_UUID},
+			tendersRes: &tenderRes{models.Tender{Id: ID_UUID, Status: models.TenderClosed}, nil},
+			want:       want{models.Tender{}, service.ErrInvalidTransition},
+		},
+		{
+			name:       "tender not found",
+			args:       args{id: ID_UUID},
+			tendersRes: &tenderRes{models.Tender{}, storage.ErrTenderNotFound},
+			want:       want{models.Tender{}, service.ErrTenderNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tStorage := mocks.NewTenderStorage(t)
+
+			tStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			if tt.tendersRes != nil {
+				tStorage.
+					On("Tender", tt.args.ctx, tt.args.id).
+					Return(tt.tendersRes.tender, tt.tendersRes.err)
+			}
+			if tt.setStatusRes != nil {
+				tStorage.
+					On("TenderSetStatus", tt.args.ctx, tt.args.id, models.TenderClosed).
+					Return(tt.setStatusRes.tender, tt.setStatusRes.err)
+				tStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			tStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			tender := Tender{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				tenderStorage: tStorage,
+				transitions:   models.NewTenderTransitions(false),
+			}
+
+			res, err := tender.Close(tt.args.ctx, tt.args.id)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want.tender, res)
@@ -334,6 +645,7 @@ func TestEdit(t *testing.T) {
 		ctx      context.Context
 		username string
 		id       uuid.UUID
+		version  int32
 		patch    models.TenderPatch
 	}
 	type want struct {
@@ -397,6 +709,23 @@ func TestEdit(t *testing.T) {
 				},
 			}, nil},
 		},
+		{
+			name: "version conflict",
+			args: args{username: "user", id: ID_UUID, version: 1, patch: models.TenderPatch{
+				Desc: ptr.Ptr("new desc"),
+			}},
+			validateRes: &validateRes{nil},
+			tenderRes: &tenderRes{models.Tender{
+				Id:      ID_UUID,
+				Version: 2,
+				TenderBase: models.TenderBase{
+					OrgId: ORG_UUID,
+					Desc:  "old desc",
+				},
+			}, nil},
+			permissionRes: &permissionRes{nil},
+			want:          want{models.TenderOut{}, service.ErrVersionConflict},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -409,8 +738,8 @@ func TestEdit(t *testing.T) {
 				Return(tt.args.ctx, nil)
 			if tt.validateRes != nil {
 				user.
-					On("Validate", tt.args.ctx, tt.args.username).
-					Return(tt.validateRes.err)
+					On("Caller", tt.args.ctx).
+					Return(tt.args.username, tt.validateRes.err)
 			}
 			if tt.tenderRes != nil {
 				tStorage.
@@ -419,7 +748,7 @@ func TestEdit(t *testing.T) {
 			}
 			if tt.permissionRes != nil {
 				user.
-					On("Permission", tt.args.ctx, tt.args.username, tt.tenderRes.tender.OrgId).
+					On("Permission", tt.args.ctx, tt.args.username, tt.tenderRes.tender.OrgId, models.ActionEdit).
 					Return(tt.permissionRes.err)
 			}
 			if tt.updateRes != nil {
@@ -428,7 +757,7 @@ func TestEdit(t *testing.T) {
 				tender.Version += 1
 
 				tStorage.
-					On("UpdateTender", tt.args.ctx, tender).
+					On("UpdateTender", tt.args.ctx, tender, tt.tenderRes.tender.Version).
 					Return(tt.updateRes.err)
 			}
 			if tt.saveTenderRes != nil {
@@ -453,7 +782,7 @@ func TestEdit(t *testing.T) {
 				rollbackSrv:   rollbackSrv,
 			}
 
-			res, err := tender.Edit(tt.args.ctx, tt.args.username, tt.args.id, tt.args.patch)
+			res, err := tender.Edit(tt.args.ctx, tt.args.id, tt.args.version, tt.args.patch)
 			if tt.want.err == nil {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.want.tender, res)
@@ -463,3 +792,247 @@ func TestEdit(t *testing.T) {
 		})
 	}
 }
+
+func TestVersions(t *testing.T) {
+	type args struct {
+		ctx           context.Context
+		username      string
+		id            uuid.UUID
+		limit, offset int32
+	}
+	type want struct {
+		tenders []models.TenderOut
+		err     error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type versionsRes struct {
+		tenders []models.Tender
+		err     error
+	}
+	tests := []struct {
+		name          string
+		args          args
+		tenderRes     *tenderRes
+		permissionRes *permissionRes
+		versionsRes   *versionsRes
+		want          want
+	}{
+		{
+			name:          "main line",
+			args:          args{username: "user", id: ID_UUID, limit: 5},
+			tenderRes:     &tenderRes{models.Tender{Id: ID_UUID, Version: 3, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{nil},
+			versionsRes: &versionsRes{[]models.Tender{
+				{Id: ID_UUID, Version: 2, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}},
+				{Id: ID_UUID, Version: 1, Status: models.TenderCreated, TenderBase: models.TenderBase{OrgId: ORG_UUID}},
+			}, nil},
+			want: want{[]models.TenderOut{
+				{Id: ID_UUID, Version: 2, Status: models.TenderPublished, TenderBase: models.TenderBase{OrgId: ORG_UUID}},
+				{Id: ID_UUID, Version: 1, Status: models.TenderCreated, TenderBase: models.TenderBase{OrgId: ORG_UUID}},
+			}, nil},
+		},
+		{
+			name:      "tender not found",
+			args:      args{username: "user", id: ID_UUID, limit: 5},
+			tenderRes: &tenderRes{models.Tender{}, storage.ErrTenderNotFound},
+			want:      want{nil, service.ErrTenderNotFound},
+		},
+		{
+			name:          "no permissions",
+			args:          args{username: "user", id: ID_UUID, limit: 5},
+			tenderRes:     &tenderRes{models.Tender{Id: ID_UUID, Version: 3, TenderBase: models.TenderBase{OrgId: ORG_UUID}}, nil},
+			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
+			want:          want{nil, service.ErrNotEnoughPrivileges},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tStorage := mocks.NewTenderStorage(t)
+			rollbackSrv := mocks.NewRollbackService(t)
+
+			tStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.tenderRes != nil {
+				tStorage.
+					On("Tender", tt.args.ctx, tt.args.id).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, tt.tenderRes.tender.OrgId, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.versionsRes != nil {
+				rollbackSrv.
+					On("TenderVersions", tt.args.ctx, tt.args.id, tt.args.limit, tt.args.offset).
+					Return(tt.versionsRes.tenders, tt.versionsRes.err)
+				if tt.versionsRes.err == nil {
+					tStorage.
+						On("Commit", tt.args.ctx).
+						Return(nil)
+				}
+			}
+			tStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			tender := Tender{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:       user,
+				tenderStorage: tStorage,
+				rollbackSrv:   rollbackSrv,
+			}
+
+			res, err := tender.Versions(tt.args.ctx, tt.args.id, tt.args.limit, tt.args.offset)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.tenders, res)
+			} else {
+				assert.EqualError(t, err, tt.want.err.Error())
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	type args struct {
+		ctx      context.Context
+		username string
+		id       uuid.UUID
+		from, to int32
+	}
+	type want struct {
+		diff models.Diff
+		err  error
+	}
+	type tenderRes struct {
+		tender models.Tender
+		err    error
+	}
+	type permissionRes struct {
+		err error
+	}
+	type versionRes struct {
+		tender models.Tender
+		err    error
+	}
+	actual := models.Tender{
+		Id:         ID_UUID,
+		Version:    3,
+		Status:     models.TenderPublished,
+		TenderBase: models.TenderBase{OrgId: ORG_UUID, Name: "new name"},
+	}
+	old := models.Tender{
+		Id:         ID_UUID,
+		Version:    1,
+		Status:     models.TenderCreated,
+		TenderBase: models.TenderBase{OrgId: ORG_UUID, Name: "name"},
+	}
+	tests := []struct {
+		name          string
+		args          args
+		tenderRes     *tenderRes
+		permissionRes *permissionRes
+		versionRes    *versionRes
+		want          want
+	}{
+		{
+			name:          "main line",
+			args:          args{username: "user", id: ID_UUID, from: 1, to: 3},
+			tenderRes:     &tenderRes{actual, nil},
+			permissionRes: &permissionRes{nil},
+			versionRes:    &versionRes{old, nil},
+			want: want{models.Diff{From: 1, To: 3, Changes: []models.FieldDiff{
+				{Field: "name", From: "name", To: "new name"},
+				{Field: "status", From: models.TenderCreated, To: models.TenderPublished},
+			}}, nil},
+		},
+		{
+			name:      "tender not found",
+			args:      args{username: "user", id: ID_UUID, from: 1, to: 3},
+			tenderRes: &tenderRes{models.Tender{}, storage.ErrTenderNotFound},
+			want:      want{models.Diff{}, service.ErrTenderNotFound},
+		},
+		{
+			name:          "no permissions",
+			args:          args{username: "user", id: ID_UUID, from: 1, to: 3},
+			tenderRes:     &tenderRes{actual, nil},
+			permissionRes: &permissionRes{service.ErrNotEnoughPrivileges},
+			want:          want{models.Diff{}, service.ErrNotEnoughPrivileges},
+		},
+		{
+			name:          "version not found",
+			args:          args{username: "user", id: ID_UUID, from: 7, to: 3},
+			tenderRes:     &tenderRes{actual, nil},
+			permissionRes: &permissionRes{nil},
+			versionRes:    &versionRes{models.Tender{}, service.ErrVersionNotFound},
+			want:          want{models.Diff{}, service.ErrVersionNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := mocks.NewUserService(t)
+			tStorage := mocks.NewTenderStorage(t)
+			rollbackSrv := mocks.NewRollbackService(t)
+
+			tStorage.
+				On("Begin", tt.args.ctx).
+				Return(tt.args.ctx, nil)
+			user.
+				On("Caller", tt.args.ctx).
+				Return(tt.args.username, nil)
+			if tt.tenderRes != nil {
+				tStorage.
+					On("Tender", tt.args.ctx, tt.args.id).
+					Return(tt.tenderRes.tender, tt.tenderRes.err)
+			}
+			if tt.permissionRes != nil {
+				user.
+					On("Permission", tt.args.ctx, tt.args.username, tt.tenderRes.tender.OrgId, models.ActionView).
+					Return(tt.permissionRes.err)
+			}
+			if tt.versionRes != nil {
+				// Actual version is not requested from rollback.
+				rollbackSrv.
+					On("TenderVersion", tt.args.ctx, tt.args.id, tt.args.from).
+					Return(tt.versionRes.tender, tt.versionRes.err)
+			}
+			if tt.want.err == nil {
+				tStorage.
+					On("Commit", tt.args.ctx).
+					Return(nil)
+			}
+			tStorage.
+				On("Rollback", tt.args.ctx).
+				Return(nil)
+
+			tender := Tender{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				userSrv:       user,
+				tenderStorage: tStorage,
+				rollbackSrv:   rollbackSrv,
+			}
+
+			res, err := tender.Diff(tt.args.ctx, tt.args.id, tt.args.from, tt.args.to)
+			if tt.want.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want.diff, res)
+			} else {
+				assert.ErrorIs(t, err, tt.want.err)
+			}
+		})
+	}
+}
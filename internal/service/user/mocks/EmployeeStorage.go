@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	models "tender/internal/models"
 
 	mock "github.com/stretchr/testify/mock"
 
@@ -15,9 +16,9 @@ type EmployeeStorage struct {
 	mock.Mock
 }
 
-// OrgSize provides a mock function with given fields: ctx, orgId
-func (_m *EmployeeStorage) OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error) {
-	ret := _m.Called(ctx, orgId)
+// OrgSize provides a mock function with given fields: ctx, orgId, roles
+func (_m *EmployeeStorage) OrgSize(ctx context.Context, orgId uuid.UUID, roles []models.Role) (int64, error) {
+	ret := _m.Called(ctx, orgId, roles)
 
 	if len(ret) == 0 {
 		panic("no return value specified for OrgSize")
@@ -25,17 +26,17 @@ func (_m *EmployeeStorage) OrgSize(ctx context.Context, orgId uuid.UUID) (int64,
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (int64, error)); ok {
-		return rf(ctx, orgId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.Role) (int64, error)); ok {
+		return rf(ctx, orgId, roles)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) int64); ok {
-		r0 = rf(ctx, orgId)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, []models.Role) int64); ok {
+		r0 = rf(ctx, orgId, roles)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, orgId)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, []models.Role) error); ok {
+		r1 = rf(ctx, orgId, roles)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,6 +74,34 @@ func (_m *EmployeeStorage) UserId(ctx context.Context, username string) (uuid.UU
 	return r0, r1
 }
 
+// UserRole provides a mock function with given fields: ctx, username, orgId
+func (_m *EmployeeStorage) UserRole(ctx context.Context, username string, orgId uuid.UUID) (models.Role, error) {
+	ret := _m.Called(ctx, username, orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserRole")
+	}
+
+	var r0 models.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (models.Role, error)); ok {
+		return rf(ctx, username, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) models.Role); ok {
+		r0 = rf(ctx, username, orgId)
+	} else {
+		r0 = ret.Get(0).(models.Role)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, username, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // VerifyOrgId provides a mock function with given fields: ctx, userId
 func (_m *EmployeeStorage) VerifyOrgId(ctx context.Context, userId uuid.UUID) (bool, error) {
 	ret := _m.Called(ctx, userId)
@@ -157,34 +186,6 @@ func (_m *EmployeeStorage) VerifyUserId(ctx context.Context, userId uuid.UUID) (
 	return r0, r1
 }
 
-// VerifyUserPermission provides a mock function with given fields: ctx, username, orgId
-func (_m *EmployeeStorage) VerifyUserPermission(ctx context.Context, username string, orgId uuid.UUID) (bool, error) {
-	ret := _m.Called(ctx, username, orgId)
-
-	if len(ret) == 0 {
-		panic("no return value specified for VerifyUserPermission")
-	}
-
-	var r0 bool
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (bool, error)); ok {
-		return rf(ctx, username, orgId)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) bool); ok {
-		r0 = rf(ctx, username, orgId)
-	} else {
-		r0 = ret.Get(0).(bool)
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
-		r1 = rf(ctx, username, orgId)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
 // NewEmployeeStorage creates a new instance of EmployeeStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEmployeeStorage(t interface {
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"log/slog"
 
+	"tender/internal/lib/auth"
 	"tender/internal/lib/logger/sl"
+	"tender/internal/models"
 	"tender/internal/service"
 	"tender/internal/storage"
 
@@ -16,6 +18,7 @@ import (
 type User struct {
 	log             *slog.Logger
 	employeeStorage EmployeeStorage
+	permissions     models.Permissions
 }
 
 //go:generate go run github.com/vektra/mockery/v2@v2.45.1 --name EmployeeStorage
@@ -24,8 +27,8 @@ type EmployeeStorage interface {
 	VerifyUserId(ctx context.Context, userId uuid.UUID) (bool, error)
 	VerifyOrgId(ctx context.Context, userId uuid.UUID) (bool, error)
 	UserId(ctx context.Context, username string) (uuid.UUID, error)
-	VerifyUserPermission(ctx context.Context, username string, orgId uuid.UUID) (bool, error)
-	OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error)
+	UserRole(ctx context.Context, username string, orgId uuid.UUID) (models.Role, error)
+	OrgSize(ctx context.Context, orgId uuid.UUID, roles []models.Role) (int64, error)
 }
 
 func New(
@@ -35,6 +38,7 @@ func New(
 	return &User{
 		log:             log,
 		employeeStorage: employeeStorage,
+		permissions:     models.NewPermissions(),
 	}
 }
 
@@ -61,6 +65,28 @@ func (u *User) Validate(ctx context.Context, username string) error {
 	return nil
 }
 
+// Caller returns username of authenticated caller and checks if user exists.
+// Caller is put to context by authentication middleware.
+func (u *User) Caller(ctx context.Context) (string, error) {
+	const op = "User.Caller"
+
+	log := u.log.With(
+		slog.String("op", op),
+	)
+
+	username, ok := auth.Username(ctx)
+	if !ok {
+		log.Warn("caller is not authenticated")
+		return "", service.ErrUnauthorized
+	}
+
+	if err := u.Validate(ctx, username); err != nil {
+		return "", err
+	}
+
+	return username, nil
+}
+
 func (u *User) ValidateUserId(ctx context.Context, userId uuid.UUID) error {
 	const op = "User.ValidateUserId"
 
@@ -123,33 +149,41 @@ func (u *User) UserId(ctx context.Context, username string) (uuid.UUID, error) {
 	return id, nil
 }
 
-// Permission checks if user is allowed to modilfy organization's tenders.
+// Permission checks if user's role in organization allows action.
 //
 // Should be called with existing username.
-func (u *User) Permission(ctx context.Context, username string, orgId uuid.UUID) error {
+func (u *User) Permission(ctx context.Context, username string, orgId uuid.UUID, action models.Action) error {
 	const op = "User.Permission"
 
 	log := u.log.With(
 		slog.String("op", op),
 		slog.String("username", username),
 		slog.String("organization id", orgId.String()),
+		slog.String("action", string(action)),
 	)
 
-	// Check if user has permissions to update tender status.
-	permOk, err := u.employeeStorage.VerifyUserPermission(ctx, username, orgId)
+	// Get user's role in organization.
+	role, err := u.employeeStorage.UserRole(ctx, username, orgId)
 	if err != nil {
-		log.Error("failed to verify user permissions", sl.Err(err))
+		if errors.Is(err, storage.ErrNotResponsible) {
+			log.Warn("user is not responsible for organization")
+			return &service.PermissionError{Action: action}
+		}
+		log.Error("failed to get user role", sl.Err(err))
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	if !permOk {
-		log.Warn("user can't update tender")
-		return service.ErrNotEnoughPrivileges
+
+	// Check if role allows action.
+	if !u.permissions.Allowed(role, action) {
+		log.Warn("action is not allowed for role", slog.String("role", string(role)))
+		return &service.PermissionError{Action: action}
 	}
 
 	return nil
 }
 
-// OrgSize returns # of employees in org.
+// OrgSize returns # of employees in org allowed to decide on bids.
+// Viewers and editors can't vote, so they don't count towards quorum.
 func (u *User) OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error) {
 	const op = "User.OrgSize"
 
@@ -158,7 +192,7 @@ func (u *User) OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error) {
 		slog.String("organization id", orgId.String()),
 	)
 
-	size, err := u.employeeStorage.OrgSize(ctx, orgId)
+	size, err := u.employeeStorage.OrgSize(ctx, orgId, u.permissions.Roles(models.ActionApprove))
 	if err != nil {
 		if errors.Is(err, storage.ErrOrgNotFound) {
 			log.Warn("org not found")
@@ -6,8 +6,12 @@ import (
 	"log/slog"
 	"os"
 
+	"tender/internal/lib/auth"
+	ptr "tender/internal/lib/utils/pointers"
+	"tender/internal/models"
 	"tender/internal/service"
 	"tender/internal/service/user/mocks"
+	"tender/internal/storage"
 	"testing"
 
 	"github.com/google/uuid"
@@ -81,15 +85,66 @@ func TestVerifyUser(t *testing.T) {
 	}
 }
 
+func TestCaller(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		verify  *bool
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "authenticated",
+			ctx:    auth.WithUsername(context.Background(), "user"),
+			verify: ptr.Ptr(true),
+			want:   "user",
+		},
+		{
+			name:    "not authenticated",
+			ctx:     context.Background(),
+			wantErr: service.ErrUnauthorized,
+		},
+		{
+			name:    "user not found",
+			ctx:     auth.WithUsername(context.Background(), "user"),
+			verify:  ptr.Ptr(false),
+			wantErr: service.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			employeeStorage := mocks.NewEmployeeStorage(t)
+
+			if tt.verify != nil {
+				employeeStorage.
+					On("VerifyUser", tt.ctx, "user").
+					Return(*tt.verify, nil)
+			}
+
+			user := User{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				employeeStorage: employeeStorage,
+			}
+
+			res, err := user.Caller(tt.ctx)
+			assert.Equal(t, tt.want, res)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestPermissions(t *testing.T) {
 	type args struct {
 		ctx      context.Context
 		username string
 		orgId    uuid.UUID
+		action   models.Action
 	}
 	type res struct {
-		ok  bool
-		err error
+		role models.Role
+		err  error
 	}
 	tests := []struct {
 		name    string
@@ -99,25 +154,37 @@ func TestPermissions(t *testing.T) {
 	}{
 		{
 			name: "allowed",
+			args: args{action: models.ActionEdit},
 			res: res{
-				ok:  true,
-				err: nil,
+				role: models.RoleEditor,
+				err:  nil,
 			},
 			wantErr: nil,
 		},
 		{
-			name: "not allowed",
+			name: "not allowed for role",
+			args: args{action: models.ActionApprove},
 			res: res{
-				ok:  false,
-				err: nil,
+				role: models.RoleEditor,
+				err:  nil,
+			},
+			wantErr: errors.New("approve permission required"),
+		},
+		{
+			name: "not responsible",
+			args: args{action: models.ActionView},
+			res: res{
+				role: "",
+				err:  storage.ErrNotResponsible,
 			},
-			wantErr: service.ErrNotEnoughPrivileges,
+			wantErr: errors.New("view permission required"),
 		},
 		{
 			name: "Unknown error",
+			args: args{action: models.ActionView},
 			res: res{
-				ok:  false,
-				err: errors.New("sql error"),
+				role: "",
+				err:  errors.New("sql error"),
 			},
 			wantErr: errors.New("User.Permission: sql error"),
 		},
@@ -128,22 +195,71 @@ func TestPermissions(t *testing.T) {
 			employeeStorage := mocks.NewEmployeeStorage(t)
 
 			employeeStorage.
-				On("VerifyUserPermission", mock.Anything, tt.args.username, tt.args.orgId).
-				Return(tt.res.ok, tt.res.err)
+				On("UserRole", mock.Anything, tt.args.username, tt.args.orgId).
+				Return(tt.res.role, tt.res.err)
 
 			user := User{
 				log: slog.New(slog.NewJSONHandler(
 					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
 				employeeStorage: employeeStorage,
+				permissions:     models.NewPermissions(),
 			}
 
-			err := user.Permission(tt.args.ctx, tt.args.username, tt.args.orgId)
+			err := user.Permission(tt.args.ctx, tt.args.username, tt.args.orgId, tt.args.action)
 
 			if tt.wantErr == nil {
 				assert.NoError(t, err)
 			} else {
 				assert.EqualError(t, err, tt.wantErr.Error())
+				if errors.Is(tt.res.err, storage.ErrNotResponsible) || tt.res.err == nil {
+					assert.ErrorIs(t, err, service.ErrNotEnoughPrivileges)
+				}
+			}
+		})
+	}
+}
+
+func TestOrgSize(t *testing.T) {
+	orgId := uuid.New()
+
+	tests := []struct {
+		name    string
+		size    int64
+		err     error
+		want    int64
+		wantErr error
+	}{
+		{
+			name: "counts approvers only",
+			size: 2,
+			want: 2,
+		},
+		{
+			name:    "org not found",
+			err:     storage.ErrOrgNotFound,
+			wantErr: service.ErrOrganizationNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			employeeStorage := mocks.NewEmployeeStorage(t)
+
+			// Viewers and editors can't vote.
+			employeeStorage.
+				On("OrgSize", mock.Anything, orgId, []models.Role{models.RoleApprover, models.RoleOrgAdmin}).
+				Return(tt.size, tt.err)
+
+			user := User{
+				log: slog.New(slog.NewJSONHandler(
+					os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug})),
+				employeeStorage: employeeStorage,
+				permissions:     models.NewPermissions(),
 			}
+
+			size, err := user.OrgSize(context.Background(), orgId)
+			assert.Equal(t, tt.want, size)
+			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
 }
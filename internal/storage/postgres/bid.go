@@ -30,10 +30,10 @@ func (s *Storage) InsertBid(ctx context.Context, bid models.Bid) (models.Bid, er
 	}
 
 	if err := w.QueryRow(ctx, `
-		INSERT INTO bid(tender_id, name, description, status, author_type, author_id, version)
-		VALUES($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO bid(tender_id, name, description, status, author_type, author_id, version, price, currency, delivery_days, warranty_months)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id, created_at
-	`, bid.TenderId, bid.Name, bid.Desc, bid.Status, bid.AuthorType, bid.AuthorId, bid.Version).
+	`, bid.TenderId, bid.Name, bid.Desc, bid.Status, bid.AuthorType, bid.AuthorId, bid.Version, bid.Price, bid.Currency, bid.DeliveryDays, bid.WarrantyMonths).
 		Scan(&bid.Id, &bid.CreatedAt); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -62,8 +62,8 @@ func (s *Storage) Bid(ctx context.Context, bidId uuid.UUID) (models.Bid, error)
 
 	var bid models.Bid
 
-	if err := w.QueryRow(ctx, `SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at FROM bid WHERE id=$1`, bidId).
-		Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt); err != nil {
+	if err := w.QueryRow(ctx, `SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months FROM bid WHERE id=$1`, bidId).
+		Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Bid{}, storage.ErrBidNotFound
 		}
@@ -77,8 +77,8 @@ func (s *Storage) Bid(ctx context.Context, bidId uuid.UUID) (models.Bid, error)
 	return bid, nil
 }
 
-// UpdateBid updates bid.
-func (s *Storage) UpdateBid(ctx context.Context, bid models.Bid) error {
+// UpdateBid updates bid if its stored version equals to given one.
+func (s *Storage) UpdateBid(ctx context.Context, bid models.Bid, version int32) error {
 	const op = "storage.Postgres.UpdateBid"
 
 	// Get worker
@@ -92,11 +92,12 @@ func (s *Storage) UpdateBid(ctx context.Context, bid models.Bid) error {
 		w = conn
 	}
 
-	if _, err := w.Exec(ctx, `
+	tag, err := w.Exec(ctx, `
 		UPDATE bid
-		SET name=$2,description=$3,status=$4,author_type=$5,author_id=$6,version=$7
-		WHERE id=$1
-	`, bid.Id, bid.Name, bid.Desc, bid.Status, bid.AuthorType, bid.AuthorId, bid.Version); err != nil {
+		SET name=$2,description=$3,status=$4,author_type=$5,author_id=$6,version=$7,price=$9,currency=$10,delivery_days=$11,warranty_months=$12
+		WHERE id=$1 AND version=$8
+	`, bid.Id, bid.Name, bid.Desc, bid.Status, bid.AuthorType, bid.AuthorId, bid.Version, version, bid.Price, bid.Currency, bid.DeliveryDays, bid.WarrantyMonths)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return storage.ErrBidNotFound
 		}
@@ -107,11 +108,19 @@ func (s *Storage) UpdateBid(ctx context.Context, bid models.Bid) error {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return storage.ErrVersionConflict
+	}
+
 	return nil
 }
 
-// TenderBids returns published bids related to tender.
-func (s *Storage) TenderBids(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.Bid, error) {
+// TenderBids returns tender's bids visible to viewer.
+// Published and decided bids are visible to everyone, other bids only
+// to their authors (see models.Bid.Public). Empty viewer sees all bids.
+// If query is given, only bids matching it are returned, most relevant first.
+// Page starts after given cursor, cursor of the next page is returned if there is one.
+func (s *Storage) TenderBids(ctx context.Context, tenderId uuid.UUID, viewer, query string, limit, offset int32, after *models.Cursor) ([]models.Bid, *models.Cursor, error) {
 	const op = "storage.Postgres.TenderBids"
 
 	// Get worker
@@ -119,54 +128,95 @@ func (s *Storage) TenderBids(ctx context.Context, tenderId uuid.UUID, limit, off
 	if w = s.tx(ctx); w == nil {
 		conn, err := s.conn(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 		defer conn.Release()
 		w = conn
 	}
 
+	// Offset is ignored if page starts after cursor.
+	var cur models.Cursor
+	if after != nil {
+		cur = *after
+		offset = 0
+	}
+
+	// One extra row is fetched to find out if there is next page.
 	rows, err := w.Query(ctx, `
-		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at
-		FROM bid
+		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months, rank
+		FROM (
+			SELECT *,
+				CASE WHEN $5::text = '' THEN 0 ELSE ts_rank(search, websearch_to_tsquery('simple', $5::text)) END AS rank
+			FROM bid
+			WHERE
+				tender_id=$1
+				AND (
+					$2::text = ''
+					OR status IN ('Published', 'Approved', 'Rejected')
+					OR (author_type='User' AND author_id IN (
+						SELECT id FROM employee WHERE username=$2::text
+					))
+					OR (author_type='Organization' AND author_id IN (
+						SELECT r.organization_id
+						FROM organization_responsible r
+						JOIN employee e ON e.id = r.user_id
+						WHERE e.username=$2::text
+					))
+				)
+				AND ($5::text = '' OR search @@ websearch_to_tsquery('simple', $5::text))
+		) b
 		WHERE
-			tender_id=$1
-			AND
-			status='Published'
-		ORDER BY name ASC
-		LIMIT $2
-		OFFSET $3
-	`, tenderId, limit, offset)
+			NOT $6::boolean
+			OR rank < $7
+			OR (rank = $7 AND (name, id) > ($8, $9))
+		ORDER BY rank DESC, name ASC, id ASC
+		LIMIT $3::int + 1
+		OFFSET $4
+	`, tenderId, viewer, limit, offset, query, after != nil, cur.Rank, cur.Name, cur.Id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, nil, nil
 		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 		}
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	var bid models.Bid
-	bids := make([]models.Bid, 0, limit)
+	var rank, lastRank float32
+	bids := make([]models.Bid, 0, limit+1)
 
 	for rows.Next() {
-		if err := rows.Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt); err != nil {
+		if err := rows.Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths, &rank); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) {
-				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+				return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 			}
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 
+		if len(bids) < int(limit) {
+			lastRank = rank
+		}
 		bids = append(bids, bid)
 	}
 
-	return slices.Clip(bids), nil
+	// Extra row means there is next page.
+	var next *models.Cursor
+	if limit > 0 && len(bids) > int(limit) {
+		bids = bids[:limit]
+		last := bids[limit-1]
+		next = &models.Cursor{Rank: lastRank, Name: last.Name, Id: last.Id}
+	}
+
+	return slices.Clip(bids), next, nil
 }
 
-// UserBids returns user's bids.
-func (s *Storage) UserBids(ctx context.Context, username string, limit, offset int32) ([]models.Bid, error) {
+// UserBids returns user's bids in alphabet order.
+// Page starts after given cursor, cursor of the next page is returned if there is one.
+func (s *Storage) UserBids(ctx context.Context, username string, limit, offset int32, after *models.Cursor) ([]models.Bid, *models.Cursor, error) {
 	const op = "storage.Postgres.UserBids"
 
 	// Get worker
@@ -174,14 +224,22 @@ func (s *Storage) UserBids(ctx context.Context, username string, limit, offset i
 	if w = s.tx(ctx); w == nil {
 		conn, err := s.conn(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 		defer conn.Release()
 		w = conn
 	}
 
+	// Offset is ignored if page starts after cursor.
+	var cur models.Cursor
+	if after != nil {
+		cur = *after
+		offset = 0
+	}
+
+	// One extra row is fetched to find out if there is next page.
 	rows, err := w.Query(ctx, `
-		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at
+		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months
 		FROM bid
 		WHERE
 			author_type='User'
@@ -191,14 +249,119 @@ func (s *Storage) UserBids(ctx context.Context, username string, limit, offset i
 				FROM employee
 				WHERE username=$1
 			)
-		ORDER BY name ASC
-		LIMIT $2
+			AND (NOT $4::boolean OR (name, id) > ($5, $6))
+		ORDER BY name ASC, id ASC
+		LIMIT $2::int + 1
 		OFFSET $3
-	`, username, limit, offset)
+	`, username, limit, offset, after != nil, cur.Name, cur.Id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, nil, nil
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var bid models.Bid
+	bids := make([]models.Bid, 0, limit+1)
+
+	for rows.Next() {
+		if err := rows.Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		bids = append(bids, bid)
+	}
+
+	// Extra row means there is next page.
+	var next *models.Cursor
+	if limit > 0 && len(bids) > int(limit) {
+		bids = bids[:limit]
+		last := bids[limit-1]
+		next = &models.Cursor{Name: last.Name, Id: last.Id}
+	}
+
+	return slices.Clip(bids), next, nil
+}
+
+// PublishedBidsByPrice returns tender's published bids, cheapest first.
+// Bids are grouped by currency, bids without price go last.
+func (s *Storage) PublishedBidsByPrice(ctx context.Context, tenderId uuid.UUID) ([]models.Bid, error) {
+	const op = "storage.Postgres.PublishedBidsByPrice"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months
+		FROM bid
+		WHERE tender_id=$1 AND status='Published'
+		ORDER BY currency ASC NULLS LAST, price ASC NULLS LAST, delivery_days ASC NULLS LAST, name ASC, id ASC
+	`, tenderId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var bid models.Bid
+	var bids []models.Bid
+
+	for rows.Next() {
+		if err := rows.Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		bids = append(bids, bid)
+	}
+
+	return bids, nil
+}
+
+// SubmittedBidsByPrice returns tender's published and decided bids, cheapest first.
+// Bids are grouped by currency, bids without price go last.
+func (s *Storage) SubmittedBidsByPrice(ctx context.Context, tenderId uuid.UUID) ([]models.Bid, error) {
+	const op = "storage.Postgres.SubmittedBidsByPrice"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months
+		FROM bid
+		WHERE tender_id=$1 AND status IN ('Published', 'Approved', 'Rejected')
+		ORDER BY currency ASC NULLS LAST, price ASC NULLS LAST, delivery_days ASC NULLS LAST, name ASC, id ASC
+	`, tenderId)
+	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
@@ -207,10 +370,10 @@ func (s *Storage) UserBids(ctx context.Context, username string, limit, offset i
 	}
 
 	var bid models.Bid
-	bids := make([]models.Bid, 0, limit)
+	var bids []models.Bid
 
 	for rows.Next() {
-		if err := rows.Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt); err != nil {
+		if err := rows.Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) {
 				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
@@ -221,7 +384,7 @@ func (s *Storage) UserBids(ctx context.Context, username string, limit, offset i
 		bids = append(bids, bid)
 	}
 
-	return slices.Clip(bids), nil
+	return bids, nil
 }
 
 // BidSetStatus updates bid status.
@@ -245,9 +408,9 @@ func (s *Storage) BidSetStatus(ctx context.Context, bidId uuid.UUID, status mode
 		UPDATE bid
 		SET status=$2
 		WHERE id=$1
-		RETURNING id, tender_id, name, description, status, author_type, author_id, version, created_at
+		RETURNING id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months
 	`, bidId, status).
-		Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt); err != nil {
+		Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Bid{}, storage.ErrBidNotFound
 		}
@@ -260,3 +423,39 @@ func (s *Storage) BidSetStatus(ctx context.Context, bidId uuid.UUID, status mode
 
 	return bid, nil
 }
+
+// TenderBidsSetStatus updates status of tender's published bids
+// except given one. Drafts are left untouched.
+func (s *Storage) TenderBidsSetStatus(ctx context.Context, tenderId, exceptBidId uuid.UUID, status models.BidStatus) error {
+	const op = "storage.Postgres.TenderBidsSetStatus"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if _, err := w.Exec(ctx, `
+		UPDATE bid
+		SET status=$3
+		WHERE
+			tender_id=$1
+			AND
+			id<>$2
+			AND
+			status='Published'
+	`, tenderId, exceptBidId, status); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"tender/internal/models"
 
@@ -12,7 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
-// InsertDecision inserts decision.
+// InsertDecision appends decision to bid's decision log.
 func (s *Storage) InsertDecision(ctx context.Context, decision models.Decision) error {
 	const op = "storage.Postgres.InsertDecision"
 
@@ -28,22 +29,9 @@ func (s *Storage) InsertDecision(ctx context.Context, decision models.Decision)
 	}
 
 	if _, err := w.Exec(ctx, `
-		DO $$
-		BEGIN
-			IF NOT EXISTS (
-				SELECT 1
-				FROM decision
-				WHERE user_id=$1 AND bid_id=$2
-			) THEN
-				INSERT INTO decision(user_id,bid_id,decision)
-				VALUES($1,$2,$3)
-			ELSE
-				UPDATE decision
-				SET decision=$3
-				WHERE user_id=$1 AND bid_id=$2
-			END IF;
-		END $$
-	`, decision.UserId, decision.BidId, decision.Decision); err != nil {
+		INSERT INTO decision(user_id, bid_id, decision, comment, bid_version)
+		VALUES($1, $2, $3, NULLIF($4, ''), $5)
+	`, decision.UserId, decision.BidId, decision.Decision, decision.Comment, decision.BidVersion); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
@@ -54,8 +42,9 @@ func (s *Storage) InsertDecision(ctx context.Context, decision models.Decision)
 	return nil
 }
 
-// Decisions returns all decisions for bid id.
-func (s *Storage) Decisions(ctx context.Context, bidId uuid.UUID) ([]models.Decision, error) {
+// Decisions returns the latest decision of each user for bid id
+// made on given version of bid.
+func (s *Storage) Decisions(ctx context.Context, bidId uuid.UUID, version int32) ([]models.Decision, error) {
 	const op = "storage.Postgres.Decision"
 
 	// Get worker
@@ -74,10 +63,11 @@ func (s *Storage) Decisions(ctx context.Context, bidId uuid.UUID) ([]models.Deci
 	decisions := make([]models.Decision, 0)
 
 	rows, err := w.Query(ctx, `
-		SELECT user_id, decision
+		SELECT DISTINCT ON (user_id) user_id, decision
 		FROM decision
-		WHERE bid_id=$1
-	`, bidId)
+		WHERE bid_id=$1 AND bid_version=$2
+		ORDER BY user_id, created_at DESC
+	`, bidId, version)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -102,3 +92,111 @@ func (s *Storage) Decisions(ctx context.Context, bidId uuid.UUID) ([]models.Deci
 
 	return decisions, nil
 }
+
+// CarryOverDecisions appends the latest decision of each user
+// on version from of bid as decision on version to.
+// Carried decisions are marked with version they were originally made on,
+// so that they are told apart from decisions made on version to.
+func (s *Storage) CarryOverDecisions(ctx context.Context, bidId uuid.UUID, from, to int32) error {
+	const op = "storage.Postgres.CarryOverDecisions"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if _, err := w.Exec(ctx, `
+		INSERT INTO decision(user_id, bid_id, decision, comment, bid_version, carried_from_version)
+		SELECT user_id, bid_id, decision, comment, $3, COALESCE(carried_from_version, bid_version)
+		FROM (
+			SELECT DISTINCT ON (user_id) user_id, bid_id, decision, comment, bid_version, carried_from_version
+			FROM decision
+			WHERE bid_id=$1 AND bid_version=$2
+			ORDER BY user_id, created_at DESC
+		) latest
+	`, bidId, from, to); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DecisionLog returns all decisions for bid id in order they were made.
+// Returns cursor of the next page if there is one.
+func (s *Storage) DecisionLog(ctx context.Context, bidId uuid.UUID, limit, offset int32, after *models.Cursor) ([]models.Decision, *models.Cursor, error) {
+	const op = "storage.Postgres.DecisionLog"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	// Offset is ignored if page starts after cursor.
+	var cur models.Cursor
+	if after != nil {
+		cur = *after
+		offset = 0
+	}
+
+	// One extra row is fetched to find out if there is next page.
+	rows, err := w.Query(ctx, `
+		SELECT d.id, d.user_id, e.username, d.bid_id, COALESCE(d.bid_version, 0), d.carried_from_version, d.decision, COALESCE(d.comment, ''), d.created_at
+		FROM decision d
+		JOIN employee e ON e.id=d.user_id
+		WHERE
+			d.bid_id=$1
+			AND (NOT $4::boolean OR (d.created_at, d.id) > ($5, $6))
+		ORDER BY d.created_at ASC, d.id ASC
+		LIMIT $2::int + 1
+		OFFSET $3
+	`, bidId, limit, offset, after != nil, cur.CreatedAt, cur.Id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	decisions := make([]models.Decision, 0, limit+1)
+
+	for rows.Next() {
+		// Fresh value per row, carried version is a pointer.
+		var d models.Decision
+		if err := rows.Scan(&d.Id, &d.UserId, &d.Username, &d.BidId, &d.BidVersion, &d.CarriedFromVersion, &d.Decision, &d.Comment, &d.CreatedAt); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		decisions = append(decisions, d)
+	}
+
+	// Extra row means there is next page.
+	var next *models.Cursor
+	if limit > 0 && len(decisions) > int(limit) {
+		decisions = decisions[:limit]
+		last := decisions[limit-1]
+		next = &models.Cursor{CreatedAt: last.CreatedAt, Id: last.Id}
+	}
+
+	return slices.Clip(decisions), next, nil
+}
@@ -0,0 +1,259 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"tender/internal/models"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// InsertEmployee inserts new employee.
+func (s *Storage) InsertEmployee(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	const op = "storage.Postgres.InsertEmployee"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if err := w.QueryRow(ctx, `
+		INSERT INTO employee(username, first_name, last_name)
+		VALUES($1, $2, $3) RETURNING id, created_at, updated_at`,
+		employee.Username, employee.FirstName, employee.LastName,
+	).Scan(&employee.Id, &employee.CreatedAt, &employee.UpdatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == uniqueViolation {
+				return models.Employee{}, storage.ErrUsernameTaken
+			}
+			return models.Employee{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return employee, nil
+}
+
+// Employee returns employee by its id.
+func (s *Storage) Employee(ctx context.Context, id uuid.UUID) (models.Employee, error) {
+	const op = "storage.Postgres.Employee"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var employee models.Employee
+
+	if err := w.QueryRow(ctx, `
+		SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, ''), created_at, updated_at
+		FROM employee
+		WHERE id=$1 AND deleted_at IS NULL
+	`, id).
+		Scan(&employee.Id, &employee.Username, &employee.FirstName, &employee.LastName, &employee.CreatedAt, &employee.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Employee{}, storage.ErrEmployeeNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Employee{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return employee, nil
+}
+
+// UpdateEmployee updates employee's names.
+func (s *Storage) UpdateEmployee(ctx context.Context, employee models.Employee) (models.Employee, error) {
+	const op = "storage.Postgres.UpdateEmployee"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if err := w.QueryRow(ctx, `
+		UPDATE employee
+		SET first_name=$2,last_name=$3,updated_at=CURRENT_TIMESTAMP
+		WHERE id=$1 AND deleted_at IS NULL
+		RETURNING updated_at
+	`, employee.Id, employee.FirstName, employee.LastName).Scan(&employee.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Employee{}, storage.ErrEmployeeNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Employee{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Employee{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return employee, nil
+}
+
+// DeleteEmployee deletes employee's memberships and anonymises employee.
+// Row itself is kept, so decisions, withdrawals and questions
+// made by employee are not cascaded away.
+func (s *Storage) DeleteEmployee(ctx context.Context, id uuid.UUID) error {
+	const op = "storage.Postgres.DeleteEmployee"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	tag, err := w.Exec(ctx, `
+		UPDATE employee
+		SET username='deleted-' || id::text, first_name=NULL, last_name=NULL,
+			deleted_at=CURRENT_TIMESTAMP, updated_at=CURRENT_TIMESTAMP
+		WHERE id=$1 AND deleted_at IS NULL
+	`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return storage.ErrEmployeeNotFound
+	}
+
+	if _, err := w.Exec(ctx, "DELETE FROM organization_responsible WHERE user_id=$1", id); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// LastOrgAdmin checks if employee is the only admin of some organization.
+// Admin rows of employee's organizations are locked until the end of transaction.
+func (s *Storage) LastOrgAdmin(ctx context.Context, id uuid.UUID) (bool, error) {
+	const op = "storage.Postgres.LastOrgAdmin"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var last bool
+
+	if err := w.QueryRow(ctx, `
+		SELECT EXISTS(
+			SELECT 1
+			FROM (
+				SELECT organization_id
+				FROM organization_responsible
+				WHERE role=$2 AND organization_id IN (
+					SELECT organization_id
+					FROM organization_responsible
+					WHERE user_id=$1 AND role=$2
+				)
+				FOR UPDATE
+			) a
+			GROUP BY organization_id
+			HAVING COUNT(*) = 1
+		)
+	`, id, models.RoleOrgAdmin).
+		Scan(&last); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return false, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return last, nil
+}
+
+// Employees returns employees in alphabet order.
+func (s *Storage) Employees(ctx context.Context, limit, offset int32) ([]models.Employee, error) {
+	const op = "storage.Postgres.Employees"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, ''), created_at, updated_at
+		FROM employee
+		WHERE deleted_at IS NULL
+		ORDER BY username ASC
+		LIMIT $1
+		OFFSET $2
+	`, limit, offset)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var employee models.Employee
+	employees := make([]models.Employee, 0, limit)
+
+	for rows.Next() {
+		if err := rows.Scan(&employee.Id, &employee.Username, &employee.FirstName, &employee.LastName, &employee.CreatedAt, &employee.UpdatedAt); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		employees = append(employees, employee)
+	}
+
+	return slices.Clip(employees), nil
+}
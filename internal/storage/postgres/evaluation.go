@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"tender/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ReplaceCriteria replaces all tender's evaluation criteria.
+// Scores given by old criteria are deleted with them.
+func (s *Storage) ReplaceCriteria(ctx context.Context, tenderId uuid.UUID, criteria []models.Criterion) ([]models.Criterion, error) {
+	const op = "storage.Postgres.ReplaceCriteria"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if _, err := w.Exec(ctx, `
+		DELETE FROM criterion
+		WHERE tender_id=$1
+	`, tenderId); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res := make([]models.Criterion, 0, len(criteria))
+
+	for _, c := range criteria {
+		if err := w.QueryRow(ctx, `
+			INSERT INTO criterion(tender_id, name, type, weight)
+			VALUES($1, $2, $3, $4)
+			RETURNING id
+		`, tenderId, c.Name, c.Type, c.Weight).Scan(&c.Id); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		c.TenderId = tenderId
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// Criteria returns tender's evaluation criteria.
+func (s *Storage) Criteria(ctx context.Context, tenderId uuid.UUID) ([]models.Criterion, error) {
+	const op = "storage.Postgres.Criteria"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT id, tender_id, name, type, weight
+		FROM criterion
+		WHERE tender_id=$1
+		ORDER BY weight DESC, name ASC
+	`, tenderId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var c models.Criterion
+	var criteria []models.Criterion
+
+	for rows.Next() {
+		if err := rows.Scan(&c.Id, &c.TenderId, &c.Name, &c.Type, &c.Weight); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		criteria = append(criteria, c)
+	}
+
+	return slices.Clip(criteria), nil
+}
+
+// InsertScores inserts scores, scores given earlier
+// by the same user for the same criteria are overwritten.
+func (s *Storage) InsertScores(ctx context.Context, scores []models.Score) error {
+	const op = "storage.Postgres.InsertScores"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	for _, score := range scores {
+		if _, err := w.Exec(ctx, `
+			INSERT INTO score(user_id, bid_id, criterion_id, score)
+			VALUES($1, $2, $3, $4)
+			ON CONFLICT (user_id, bid_id, criterion_id)
+			DO UPDATE SET score=EXCLUDED.score, updated_at=CURRENT_TIMESTAMP
+		`, score.UserId, score.BidId, score.CriterionId, score.Score); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	return nil
+}
+
+// BidScores returns average scores of tender's bids per criterion.
+func (s *Storage) BidScores(ctx context.Context, tenderId uuid.UUID) ([]models.CriterionScore, error) {
+	const op = "storage.Postgres.BidScores"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT s.bid_id, s.criterion_id, AVG(s.score)::float8, COUNT(*)::integer
+		FROM score s
+		JOIN criterion c ON c.id=s.criterion_id
+		WHERE c.tender_id=$1
+		GROUP BY s.bid_id, s.criterion_id
+	`, tenderId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var score models.CriterionScore
+	var scores []models.CriterionScore
+
+	for rows.Next() {
+		if err := rows.Scan(&score.BidId, &score.CriterionId, &score.Average, &score.Count); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		scores = append(scores, score)
+	}
+
+	return slices.Clip(scores), nil
+}
@@ -0,0 +1,467 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"tender/internal/models"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// InsertOrg inserts new organization.
+func (s *Storage) InsertOrg(ctx context.Context, org models.Org) (models.Org, error) {
+	const op = "storage.Postgres.InsertOrg"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Org{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if err := w.QueryRow(ctx, `
+		INSERT INTO organization(name, description, type, quorum_type, quorum_value, quorum_veto)
+		VALUES($1, $2, $3, $4, $5, $6) RETURNING id, created_at, updated_at`,
+		org.Name, org.Desc, org.Type, org.Quorum.Type, org.Quorum.Value, org.Quorum.Veto,
+	).Scan(&org.Id, &org.CreatedAt, &org.UpdatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Org{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Org{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org, nil
+}
+
+// Org returns organization by its id.
+func (s *Storage) Org(ctx context.Context, id uuid.UUID) (models.Org, error) {
+	const op = "storage.Postgres.Org"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Org{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var org models.Org
+
+	if err := w.QueryRow(ctx, `
+		SELECT id, name, COALESCE(description, ''), type, quorum_type, quorum_value, quorum_veto, created_at, updated_at
+		FROM organization
+		WHERE id=$1
+	`, id).
+		Scan(&org.Id, &org.Name, &org.Desc, &org.Type, &org.Quorum.Type, &org.Quorum.Value, &org.Quorum.Veto, &org.CreatedAt, &org.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Org{}, storage.ErrOrgNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Org{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Org{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org, nil
+}
+
+// OrgQuorum returns organization's quorum policy.
+func (s *Storage) OrgQuorum(ctx context.Context, id uuid.UUID) (models.Quorum, error) {
+	const op = "storage.Postgres.OrgQuorum"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Quorum{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var quorum models.Quorum
+
+	if err := w.QueryRow(ctx, `
+		SELECT quorum_type, quorum_value, quorum_veto
+		FROM organization
+		WHERE id=$1
+	`, id).
+		Scan(&quorum.Type, &quorum.Value, &quorum.Veto); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Quorum{}, storage.ErrOrgNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Quorum{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Quorum{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return quorum, nil
+}
+
+// UpdateOrg updates organization.
+func (s *Storage) UpdateOrg(ctx context.Context, org models.Org) (models.Org, error) {
+	const op = "storage.Postgres.UpdateOrg"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Org{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if err := w.QueryRow(ctx, `
+		UPDATE organization
+		SET name=$2,description=$3,type=$4,quorum_type=$5,quorum_value=$6,quorum_veto=$7,updated_at=CURRENT_TIMESTAMP
+		WHERE id=$1
+		RETURNING updated_at
+	`, org.Id, org.Name, org.Desc, org.Type, org.Quorum.Type, org.Quorum.Value, org.Quorum.Veto).Scan(&org.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Org{}, storage.ErrOrgNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Org{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Org{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return org, nil
+}
+
+// DeleteOrg deletes organization with its tenders and responsibles.
+func (s *Storage) DeleteOrg(ctx context.Context, id uuid.UUID) error {
+	const op = "storage.Postgres.DeleteOrg"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	tag, err := w.Exec(ctx, "DELETE FROM organization WHERE id=$1", id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return storage.ErrOrgNotFound
+	}
+
+	return nil
+}
+
+// Orgs returns organizations in alphabet order.
+func (s *Storage) Orgs(ctx context.Context, limit, offset int32) ([]models.Org, error) {
+	const op = "storage.Postgres.Orgs"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT id, name, COALESCE(description, ''), type, quorum_type, quorum_value, quorum_veto, created_at, updated_at
+		FROM organization
+		ORDER BY name ASC
+		LIMIT $1
+		OFFSET $2
+	`, limit, offset)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var org models.Org
+	orgs := make([]models.Org, 0, limit)
+
+	for rows.Next() {
+		if err := rows.Scan(&org.Id, &org.Name, &org.Desc, &org.Type, &org.Quorum.Type, &org.Quorum.Value, &org.Quorum.Veto, &org.CreatedAt, &org.UpdatedAt); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		orgs = append(orgs, org)
+	}
+
+	return slices.Clip(orgs), nil
+}
+
+// InsertResponsible makes user responsible for organization.
+func (s *Storage) InsertResponsible(ctx context.Context, orgId, userId uuid.UUID, role models.Role) error {
+	const op = "storage.Postgres.InsertResponsible"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	tag, err := w.Exec(ctx, `
+		INSERT INTO organization_responsible(organization_id, user_id, role)
+		SELECT $1, $2, $3
+		WHERE NOT EXISTS(
+			SELECT 1
+			FROM organization_responsible
+			WHERE organization_id=$1 AND user_id=$2
+		)
+	`, orgId, userId, role)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == foreignKeyViolation {
+				return storage.ErrOrgNotFound
+			}
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return storage.ErrAlreadyResponsible
+	}
+
+	return nil
+}
+
+// Responsible returns user responsible for organization.
+func (s *Storage) Responsible(ctx context.Context, orgId, userId uuid.UUID) (models.Responsible, error) {
+	const op = "storage.Postgres.Responsible"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Responsible{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var r models.Responsible
+
+	if err := w.QueryRow(ctx, `
+		SELECT e.id, e.username, COALESCE(e.first_name, ''), COALESCE(e.last_name, ''), e.created_at, e.updated_at, r.organization_id, r.role
+		FROM organization_responsible r
+		JOIN employee e ON e.id = r.user_id
+		WHERE r.organization_id=$1 AND r.user_id=$2
+	`, orgId, userId).
+		Scan(&r.Id, &r.Username, &r.FirstName, &r.LastName, &r.CreatedAt, &r.UpdatedAt, &r.OrgId, &r.Role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Responsible{}, storage.ErrNotResponsible
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Responsible{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Responsible{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r, nil
+}
+
+// DeleteResponsible removes user from organization's responsibles.
+func (s *Storage) DeleteResponsible(ctx context.Context, orgId, userId uuid.UUID) error {
+	const op = "storage.Postgres.DeleteResponsible"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	tag, err := w.Exec(ctx, `
+		DELETE FROM organization_responsible
+		WHERE organization_id=$1 AND user_id=$2
+	`, orgId, userId)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotResponsible
+	}
+
+	return nil
+}
+
+// UpdateResponsible changes role of user responsible for organization.
+func (s *Storage) UpdateResponsible(ctx context.Context, orgId, userId uuid.UUID, role models.Role) error {
+	const op = "storage.Postgres.UpdateResponsible"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	tag, err := w.Exec(ctx, `
+		UPDATE organization_responsible
+		SET role=$3
+		WHERE organization_id=$1 AND user_id=$2
+	`, orgId, userId, role)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return storage.ErrNotResponsible
+	}
+
+	return nil
+}
+
+// OrgAdmins returns # of organization's admins.
+// Admin rows are locked until the end of transaction,
+// so concurrent demotions can't leave organization without admin.
+func (s *Storage) OrgAdmins(ctx context.Context, orgId uuid.UUID) (int64, error) {
+	const op = "storage.Postgres.OrgAdmins"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var admins int64
+
+	if err := w.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM (
+			SELECT 1
+			FROM organization_responsible
+			WHERE organization_id=$1 AND role=$2
+			FOR UPDATE
+		) a
+	`, orgId, models.RoleOrgAdmin).
+		Scan(&admins); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return 0, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return admins, nil
+}
+
+// Responsibles returns employees responsible for organization.
+func (s *Storage) Responsibles(ctx context.Context, orgId uuid.UUID, limit, offset int32) ([]models.Responsible, error) {
+	const op = "storage.Postgres.Responsibles"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT e.id, e.username, COALESCE(e.first_name, ''), COALESCE(e.last_name, ''), e.created_at, e.updated_at, r.organization_id, r.role
+		FROM organization_responsible r
+		JOIN employee e ON e.id = r.user_id
+		WHERE r.organization_id=$1
+		ORDER BY e.username ASC
+		LIMIT $2
+		OFFSET $3
+	`, orgId, limit, offset)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var r models.Responsible
+	responsibles := make([]models.Responsible, 0, limit)
+
+	for rows.Next() {
+		if err := rows.Scan(&r.Id, &r.Username, &r.FirstName, &r.LastName, &r.CreatedAt, &r.UpdatedAt, &r.OrgId, &r.Role); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		responsibles = append(responsibles, r)
+	}
+
+	return slices.Clip(responsibles), nil
+}
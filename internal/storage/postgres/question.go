@@ -0,0 +1,216 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"tender/internal/models"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// InsertQuestion saves question on tender.
+func (s *Storage) InsertQuestion(ctx context.Context, question models.Question) (models.Question, error) {
+	const op = "storage.Postgres.InsertQuestion"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Question{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if err := w.QueryRow(ctx, `
+		INSERT INTO question(tender_id, user_id, text)
+		VALUES($1, $2, $3)
+		RETURNING id, created_at
+	`, question.TenderId, question.UserId, question.Text).
+		Scan(&question.Id, &question.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Question{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Question{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return question, nil
+}
+
+// Question returns question by id without answer.
+func (s *Storage) Question(ctx context.Context, questionId uuid.UUID) (models.Question, error) {
+	const op = "storage.Postgres.Question"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Question{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var q models.Question
+
+	if err := w.QueryRow(ctx, `
+		SELECT q.id, q.tender_id, q.user_id, e.username, q.text, q.created_at
+		FROM question q
+		JOIN employee e ON e.id=q.user_id
+		WHERE q.id=$1
+	`, questionId).
+		Scan(&q.Id, &q.TenderId, &q.UserId, &q.Username, &q.Text, &q.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Question{}, storage.ErrQuestionNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Question{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Question{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return q, nil
+}
+
+// Questions returns tender's questions with answers, earliest first.
+// If all is not set, only questions of user id and
+// questions with public answers are returned.
+// Returns cursor of the next page if there is one.
+func (s *Storage) Questions(ctx context.Context, tenderId, userId uuid.UUID, all bool, limit, offset int32, after *models.Cursor) ([]models.Question, *models.Cursor, error) {
+	const op = "storage.Postgres.Questions"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	// Offset is ignored if page starts after cursor.
+	var cur models.Cursor
+	if after != nil {
+		cur = *after
+		offset = 0
+	}
+
+	// One extra row is fetched to find out if there is next page.
+	rows, err := w.Query(ctx, `
+		SELECT
+			q.id, q.tender_id, q.user_id, qe.username, q.text, q.created_at,
+			a.id, a.user_id, ae.username, a.text, a.public, a.created_at
+		FROM question q
+		JOIN employee qe ON qe.id=q.user_id
+		LEFT JOIN answer a ON a.question_id=q.id
+		LEFT JOIN employee ae ON ae.id=a.user_id
+		WHERE
+			q.tender_id=$1
+			AND ($2::boolean OR q.user_id=$3 OR COALESCE(a.public, FALSE))
+			AND (NOT $6::boolean OR (q.created_at, q.id) > ($7, $8))
+		ORDER BY q.created_at ASC, q.id ASC
+		LIMIT $4::int + 1
+		OFFSET $5
+	`, tenderId, all, userId, limit, offset, after != nil, cur.CreatedAt, cur.Id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	questions := make([]models.Question, 0, limit+1)
+
+	for rows.Next() {
+		var (
+			q                  models.Question
+			answerId, answerer *uuid.UUID
+			answererName, text *string
+			public             *bool
+			answeredAt         *time.Time
+		)
+		if err := rows.Scan(
+			&q.Id, &q.TenderId, &q.UserId, &q.Username, &q.Text, &q.CreatedAt,
+			&answerId, &answerer, &answererName, &text, &public, &answeredAt,
+		); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Unanswered question has no answer columns.
+		if answerId != nil {
+			q.Answer = &models.Answer{
+				Id:         *answerId,
+				QuestionId: q.Id,
+				UserId:     *answerer,
+				Username:   *answererName,
+				Text:       *text,
+				Public:     *public,
+				CreatedAt:  *answeredAt,
+			}
+		}
+
+		questions = append(questions, q)
+	}
+
+	// Extra row means there is next page.
+	var next *models.Cursor
+	if limit > 0 && len(questions) > int(limit) {
+		questions = questions[:limit]
+		last := questions[limit-1]
+		next = &models.Cursor{CreatedAt: last.CreatedAt, Id: last.Id}
+	}
+
+	return slices.Clip(questions), next, nil
+}
+
+// UpsertAnswer saves answer on question.
+// Previous answer on the same question is replaced.
+func (s *Storage) UpsertAnswer(ctx context.Context, answer models.Answer) (models.Answer, error) {
+	const op = "storage.Postgres.UpsertAnswer"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Answer{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if err := w.QueryRow(ctx, `
+		INSERT INTO answer(question_id, user_id, text, public)
+		VALUES($1, $2, $3, $4)
+		ON CONFLICT (question_id) DO UPDATE
+		SET user_id=EXCLUDED.user_id, text=EXCLUDED.text, public=EXCLUDED.public, created_at=CURRENT_TIMESTAMP
+		RETURNING id, created_at
+	`, answer.QuestionId, answer.UserId, answer.Text, answer.Public).
+		Scan(&answer.Id, &answer.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Answer{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Answer{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return answer, nil
+}
@@ -46,8 +46,9 @@ func (s *Storage) InsertReview(ctx context.Context, review models.Review) (uuid.
 	return id, nil
 }
 
-// Reviews returns review by their author and tender.
-func (s *Storage) Reviews(ctx context.Context, tenderId uuid.UUID, author string, limit, offset int32) ([]models.Review, error) {
+// Reviews returns reviews by their author and tender, oldest first.
+// Page starts after given cursor, cursor of the next page is returned if there is one.
+func (s *Storage) Reviews(ctx context.Context, tenderId uuid.UUID, author string, limit, offset int32, after *models.Cursor) ([]models.Review, *models.Cursor, error) {
 	const op = "storage.Postgres.Reviews"
 
 	// Get worker
@@ -55,49 +56,68 @@ func (s *Storage) Reviews(ctx context.Context, tenderId uuid.UUID, author string
 	if w = s.tx(ctx); w == nil {
 		conn, err := s.conn(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 		defer conn.Release()
 		w = conn
 	}
 
+	// Offset is ignored if page starts after cursor.
+	var cur models.Cursor
+	if after != nil {
+		cur = *after
+		offset = 0
+	}
+
+	// One extra row is fetched to find out if there is next page.
 	rows, err := w.Query(ctx, `
 		SELECT id, bid_id, description, author, created_at
 		FROM review
-		WHERE 
+		WHERE
 			bid_id IN (
 				SELECT id
 				FROM bid
 				WHERE tender_id=$1
 			) AND
 			author=$2
-
-	`, tenderId, author)
+			AND (NOT $5::boolean OR (created_at, id) > ($6, $7))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $3::int + 1
+		OFFSET $4
+	`, tenderId, author, limit, offset, after != nil, cur.CreatedAt, cur.Id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
+			return nil, nil, nil
 		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 		}
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	var review models.Review
-	reviews := make([]models.Review, 0, limit)
+	reviews := make([]models.Review, 0, limit+1)
 
 	for rows.Next() {
 		if err := rows.Scan(&review.Id, &review.BidId, &review.Desc, &review.AuthorName, &review.CreatedAt); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) {
-				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+				return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 			}
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 
 		reviews = append(reviews, review)
 	}
 
-	return slices.Clip(reviews), nil
+	// Extra row means there is next page.
+	var next *models.Cursor
+	if limit > 0 && len(reviews) > int(limit) {
+		reviews = reviews[:limit]
+		last := reviews[limit-1]
+		next = &models.Cursor{CreatedAt: last.CreatedAt, Id: last.Id}
+	}
+
+	return slices.Clip(reviews), next, nil
 }
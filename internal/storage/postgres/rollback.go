@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"tender/internal/models"
 	"tender/internal/storage"
@@ -29,9 +30,10 @@ func (s *Storage) SaveTender(ctx context.Context, tender models.Tender) error {
 	}
 
 	if _, err := w.Exec(ctx, `
-		INSERT INTO rollback_tender(id, organization_id, name, description, type, status, version, created_at)
-		VALUES($1, $2, $3, $4, $5, $6, $7, $8)
-	`, tender.Id, tender.OrgId, tender.Name, tender.Desc, tender.ServiceType, tender.Status, tender.Version, tender.CreatedAt); err != nil {
+		INSERT INTO rollback_tender(id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`, tender.Id, tender.OrgId, tender.Name, tender.Desc, tender.ServiceType, tender.Status, tender.Version, tender.CreatedAt, tender.Deadline,
+		tender.Budget, tender.Currency, tender.Quantity, tender.Unit, tender.Location, tender.Requirements); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
@@ -58,9 +60,9 @@ func (s *Storage) SaveBid(ctx context.Context, bid models.Bid) error {
 	}
 
 	if _, err := w.Exec(ctx, `
-		INSERT INTO rollback_bid(id, tender_id, name, description, status, author_type, author_id, version, created_at)
-		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9)
-	`, bid.Id, bid.TenderId, bid.Name, bid.Desc, bid.Status, bid.AuthorType, bid.AuthorId, bid.Version, bid.CreatedAt); err != nil {
+		INSERT INTO rollback_bid(id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`, bid.Id, bid.TenderId, bid.Name, bid.Desc, bid.Status, bid.AuthorType, bid.AuthorId, bid.Version, bid.CreatedAt, bid.Price, bid.Currency, bid.DeliveryDays, bid.WarrantyMonths); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			return fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
@@ -89,11 +91,11 @@ func (s *Storage) RecoverTender(ctx context.Context, tenderId uuid.UUID, version
 	var tender models.Tender
 
 	if err := w.QueryRow(ctx, `
-		SELECT id, organization_id, name, description, type, status, version, created_at
+		SELECT id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements
 		FROM rollback_tender
 		WHERE id=$1 AND version=$2
 	`, tenderId, version).
-		Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt); err != nil {
+		Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt, &tender.Deadline, &tender.Budget, &tender.Currency, &tender.Quantity, &tender.Unit, &tender.Location, &tender.Requirements); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Tender{}, storage.ErrVersionNotFound
 		}
@@ -125,11 +127,11 @@ func (s *Storage) RecoverBid(ctx context.Context, bidId uuid.UUID, version int32
 	var bid models.Bid
 
 	if err := w.QueryRow(ctx, `
-		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at
+		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months
 		FROM rollback_bid
 		WHERE id=$1 AND version=$2
 	`, bidId, version).
-		Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt); err != nil {
+		Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Bid{}, storage.ErrVersionNotFound
 		}
@@ -142,3 +144,107 @@ func (s *Storage) RecoverBid(ctx context.Context, bidId uuid.UUID, version int32
 
 	return bid, nil
 }
+
+// TenderVersions returns outdated versions of tender starting from the latest.
+func (s *Storage) TenderVersions(ctx context.Context, tenderId uuid.UUID, limit, offset int32) ([]models.Tender, error) {
+	const op = "storage.Postgres.TenderVersions"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements
+		FROM rollback_tender
+		WHERE id=$1
+		ORDER BY version DESC
+		LIMIT $2
+		OFFSET $3
+	`, tenderId, limit, offset)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var tender models.Tender
+	tenders := make([]models.Tender, 0, limit)
+
+	for rows.Next() {
+		if err := rows.Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt, &tender.Deadline, &tender.Budget, &tender.Currency, &tender.Quantity, &tender.Unit, &tender.Location, &tender.Requirements); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		tenders = append(tenders, tender)
+	}
+
+	return slices.Clip(tenders), nil
+}
+
+// BidVersions returns outdated versions of bid starting from the latest.
+func (s *Storage) BidVersions(ctx context.Context, bidId uuid.UUID, limit, offset int32) ([]models.Bid, error) {
+	const op = "storage.Postgres.BidVersions"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		SELECT id, tender_id, name, description, status, author_type, author_id, version, created_at, price, currency, delivery_days, warranty_months
+		FROM rollback_bid
+		WHERE id=$1
+		ORDER BY version DESC
+		LIMIT $2
+		OFFSET $3
+	`, bidId, limit, offset)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var bid models.Bid
+	bids := make([]models.Bid, 0, limit)
+
+	for rows.Next() {
+		if err := rows.Scan(&bid.Id, &bid.TenderId, &bid.Name, &bid.Desc, &bid.Status, &bid.AuthorType, &bid.AuthorId, &bid.Version, &bid.CreatedAt, &bid.Price, &bid.Currency, &bid.DeliveryDays, &bid.WarrantyMonths); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		bids = append(bids, bid)
+	}
+
+	return slices.Clip(bids), nil
+}
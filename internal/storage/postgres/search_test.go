@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"tender/internal/models"
+)
+
+// testStorage returns storage connected to migrated test database.
+// Test is skipped if POSTGRES_TEST_URL is not set.
+func testStorage(t *testing.T) *Storage {
+	t.Helper()
+
+	url := os.Getenv("POSTGRES_TEST_URL")
+	if url == "" {
+		t.Skip("POSTGRES_TEST_URL is not set")
+	}
+
+	m, err := migrate.New("file://../../../migrations", url)
+	require.NoError(t, err)
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		require.NoError(t, err)
+	}
+
+	s, err := New(url)
+	require.NoError(t, err)
+	t.Cleanup(s.Stop)
+
+	return s
+}
+
+func TestSearchRanking(t *testing.T) {
+	s := testStorage(t)
+
+	// Everything is inserted in transaction which is rolled back.
+	ctx, err := s.Begin(context.Background())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Rollback(ctx))
+	}()
+
+	org, err := s.InsertOrg(ctx, models.Org{
+		OrgBase: models.OrgBase{Name: "search org", Type: models.LLC},
+		Quorum:  models.DefaultQuorum(),
+	})
+	require.NoError(t, err)
+
+	// Names are chosen so that relevance and alphabet orders differ.
+	insertTender := func(name, desc string) uuid.UUID {
+		tender, err := s.InsertTender(ctx, models.Tender{
+			TenderBase: models.TenderBase{
+				OrgId:       org.Id,
+				Name:        name,
+				Desc:        desc,
+				ServiceType: models.Construction,
+			},
+			Status:  models.TenderPublished,
+			Version: 1,
+		})
+		require.NoError(t, err)
+		return tender.Id
+	}
+	lighting := insertTender("A road lighting", "street lamps")
+	repair := insertTender("B road repair", "road surface repair and road marking")
+	insertTender("C office cleaning", "daily cleaning")
+
+	t.Run("tenders", func(t *testing.T) {
+		filter := models.TenderFilter{OrgId: &org.Id, Query: "road"}
+
+		tenders, next, err := s.Tenders(ctx, 5, 0, nil, filter)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+
+		ids := make([]uuid.UUID, 0, len(tenders))
+		for i := range tenders {
+			ids = append(ids, tenders[i].Id)
+		}
+		assert.Equal(t, []uuid.UUID{repair, lighting}, ids)
+	})
+
+	t.Run("tenders page after ranked cursor", func(t *testing.T) {
+		filter := models.TenderFilter{OrgId: &org.Id, Query: "road"}
+
+		first, next, err := s.Tenders(ctx, 1, 0, nil, filter)
+		require.NoError(t, err)
+		require.Len(t, first, 1)
+		require.NotNil(t, next)
+		assert.Equal(t, repair, first[0].Id)
+
+		second, next, err := s.Tenders(ctx, 1, 0, next, filter)
+		require.NoError(t, err)
+		require.Len(t, second, 1)
+		assert.Nil(t, next)
+		assert.Equal(t, lighting, second[0].Id)
+	})
+
+	t.Run("bids", func(t *testing.T) {
+		insertBid := func(name, desc string) uuid.UUID {
+			bid, err := s.InsertBid(ctx, models.Bid{
+				BidBase: models.BidBase{
+					TenderId:   repair,
+					Name:       name,
+					Desc:       desc,
+					AuthorType: models.Organization,
+					AuthorId:   org.Id,
+				},
+				Status:  models.BidPublished,
+				Version: 1,
+			})
+			require.NoError(t, err)
+			return bid.Id
+		}
+		asphalt := insertBid("A asphalt", "asphalt supply")
+		full := insertBid("B full repair", "asphalt laying, asphalt rolling and asphalt marking")
+		insertBid("C marking", "paint only")
+
+		bids, next, err := s.TenderBids(ctx, repair, "", "asphalt", 5, 0, nil)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+
+		ids := make([]uuid.UUID, 0, len(bids))
+		for i := range bids {
+			ids = append(ids, bids[i].Id)
+		}
+		assert.Equal(t, []uuid.UUID{full, asphalt}, ids)
+	})
+}
@@ -31,9 +31,10 @@ func (s *Storage) InsertTender(ctx context.Context, tender models.Tender) (model
 	}
 
 	if err := w.QueryRow(ctx, `
-		INSERT INTO tender(organization_id, name, description, type, status, version)
-		VALUES($1, $2, $3, $4, $5, $6) RETURNING id, created_at`,
-		tender.OrgId, tender.Name, tender.Desc, tender.ServiceType, tender.Status, tender.Version,
+		INSERT INTO tender(organization_id, name, description, type, status, version, deadline, budget, currency, quantity, unit, location, requirements)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) RETURNING id, created_at`,
+		tender.OrgId, tender.Name, tender.Desc, tender.ServiceType, tender.Status, tender.Version, tender.Deadline,
+		tender.Budget, tender.Currency, tender.Quantity, tender.Unit, tender.Location, tender.Requirements,
 	).Scan(&tender.Id, &tender.CreatedAt); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -62,8 +63,8 @@ func (s *Storage) Tender(ctx context.Context, id uuid.UUID) (models.Tender, erro
 
 	var tender models.Tender
 
-	if err := w.QueryRow(ctx, `SELECT id, organization_id, name, description, type, status, version, created_at FROM tender WHERE id=$1`, id).
-		Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt); err != nil {
+	if err := w.QueryRow(ctx, `SELECT id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements FROM tender WHERE id=$1`, id).
+		Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt, &tender.Deadline, &tender.Budget, &tender.Currency, &tender.Quantity, &tender.Unit, &tender.Location, &tender.Requirements); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Tender{}, storage.ErrTenderNotFound
 		}
@@ -77,8 +78,8 @@ func (s *Storage) Tender(ctx context.Context, id uuid.UUID) (models.Tender, erro
 	return tender, nil
 }
 
-// UpdateTender updates tender.
-func (s *Storage) UpdateTender(ctx context.Context, tender models.Tender) error {
+// UpdateTender updates tender if its stored version equals to given one.
+func (s *Storage) UpdateTender(ctx context.Context, tender models.Tender, version int32) error {
 	const op = "storage.Postgres.UpdateTender"
 
 	// Get worker
@@ -92,11 +93,14 @@ func (s *Storage) UpdateTender(ctx context.Context, tender models.Tender) error
 		w = conn
 	}
 
-	if _, err := w.Exec(ctx, `
+	tag, err := w.Exec(ctx, `
 		UPDATE tender
-		SET organization_id=$2,name=$3,description=$4,type=$5,status=$6,version=$7
-		WHERE id=$1
-	`, tender.Id, tender.OrgId, tender.Name, tender.Desc, tender.ServiceType, tender.Status, tender.Version); err != nil {
+		SET organization_id=$2,name=$3,description=$4,type=$5,status=$6,version=$7,deadline=$9,
+			budget=$10,currency=$11,quantity=$12,unit=$13,location=$14,requirements=$15
+		WHERE id=$1 AND version=$8
+	`, tender.Id, tender.OrgId, tender.Name, tender.Desc, tender.ServiceType, tender.Status, tender.Version, version, tender.Deadline,
+		tender.Budget, tender.Currency, tender.Quantity, tender.Unit, tender.Location, tender.Requirements)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return storage.ErrTenderNotFound
 		}
@@ -107,11 +111,18 @@ func (s *Storage) UpdateTender(ctx context.Context, tender models.Tender) error
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	if tag.RowsAffected() == 0 {
+		return storage.ErrVersionConflict
+	}
+
 	return nil
 }
 
-// Tenders returns tenders in alphabet order.
-func (s *Storage) Tenders(ctx context.Context, limit, offset int32, services []models.ServiceType) ([]models.Tender, error) {
+// Tenders returns tenders matching filter ordered by filter's sort,
+// by name if it is not set. Tenders sorted by name go most relevant
+// first if filter has query.
+// Page starts after given cursor, cursor of the next page is returned if there is one.
+func (s *Storage) Tenders(ctx context.Context, limit, offset int32, after *models.Cursor, filter models.TenderFilter) ([]models.Tender, *models.Cursor, error) {
 	const op = "storage.Postgres.Tenders"
 
 	// Get worker
@@ -119,61 +130,139 @@ func (s *Storage) Tenders(ctx context.Context, limit, offset int32, services []m
 	if w = s.tx(ctx); w == nil {
 		conn, err := s.conn(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 		defer conn.Release()
 		w = conn
 	}
 
-	types := make([]string, 0, len(services))
-	for _, s := range services {
-		types = append(types, "'"+string(s)+"'")
+	// Offset is ignored if page starts after cursor.
+	if after != nil {
+		offset = 0
+	}
+
+	// Values are passed as parameters, arg returns placeholder of added one.
+	args := []any{limit, offset}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	statuses := []string{string(models.TenderPublished)}
+	if len(filter.Statuses) > 0 {
+		statuses = make([]string, 0, len(filter.Statuses))
+		for _, st := range filter.Statuses {
+			statuses = append(statuses, string(st))
+		}
+	}
+
+	conditions := []string{"status::text = ANY(" + arg(statuses) + "::text[])"}
+	if len(filter.ServiceTypes) > 0 {
+		types := make([]string, 0, len(filter.ServiceTypes))
+		for _, t := range filter.ServiceTypes {
+			types = append(types, string(t))
+		}
+		conditions = append(conditions, "type::text = ANY("+arg(types)+"::text[])")
+	}
+	if filter.OrgId != nil {
+		conditions = append(conditions, "organization_id = "+arg(*filter.OrgId))
+	}
+	if filter.CreatedFrom != nil {
+		conditions = append(conditions, "created_at >= "+arg(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		conditions = append(conditions, "created_at < "+arg(*filter.CreatedTo))
+	}
+	if filter.Version != nil {
+		conditions = append(conditions, "version = "+arg(*filter.Version))
 	}
 
-	typeCondition := ""
-	if len(types) > 0 {
-		typeCondition = fmt.Sprintf("AND type IN (%s)", strings.Join(types, ","))
+	relevance := "0::real"
+	if filter.Query != "" {
+		query := arg(filter.Query)
+		conditions = append(conditions, "search @@ websearch_to_tsquery('simple', "+query+"::text)")
+		relevance = "ts_rank(search, websearch_to_tsquery('simple', " + query + "::text))"
 	}
 
+	// Order and page start depend on sort.
+	order, start := "", "TRUE"
+	switch filter.Sort {
+	case models.SortByCreatedAt:
+		order = "created_at ASC, id ASC"
+		if after != nil {
+			start = fmt.Sprintf("(created_at, id) > (%s, %s)", arg(after.CreatedAt), arg(after.Id))
+		}
+	case models.SortByCreatedAtDesc:
+		order = "created_at DESC, id DESC"
+		if after != nil {
+			start = fmt.Sprintf("(created_at, id) < (%s, %s)", arg(after.CreatedAt), arg(after.Id))
+		}
+	default:
+		order = "rank DESC, name ASC, id ASC"
+		if after != nil {
+			start = fmt.Sprintf("(rank < %[1]s OR (rank = %[1]s AND (name, id) > (%[2]s, %[3]s)))", arg(after.Rank), arg(after.Name), arg(after.Id))
+		}
+	}
+
+	// One extra row is fetched to find out if there is next page.
 	rows, err := w.Query(ctx, fmt.Sprintf(`
-		SELECT id, organization_id, name, description, type, status, version, created_at
-		FROM tender
-		WHERE status='Published' %s
-		ORDER BY name ASC
-		LIMIT $1
+		SELECT id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements, rank
+		FROM (
+			SELECT *, %s AS rank
+			FROM tender
+			WHERE %s
+		) t
+		WHERE %s
+		ORDER BY %s
+		LIMIT $1::int + 1
 		OFFSET $2
-	`, typeCondition), limit, offset)
+	`, relevance, strings.Join(conditions, " AND "), start, order), args...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, storage.ErrTenderNotFound
+			return nil, nil, storage.ErrTenderNotFound
 		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 		}
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	var tender models.Tender
-	tenders := make([]models.Tender, 0, limit)
+	var rank, lastRank float32
+	tenders := make([]models.Tender, 0, limit+1)
 
 	for rows.Next() {
-		if err := rows.Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt); err != nil {
+		if err := rows.Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt, &tender.Deadline, &tender.Budget, &tender.Currency, &tender.Quantity, &tender.Unit, &tender.Location, &tender.Requirements, &rank); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) {
-				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+				return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 			}
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 
+		if len(tenders) < int(limit) {
+			lastRank = rank
+		}
 		tenders = append(tenders, tender)
 	}
 
-	return slices.Clip(tenders), nil
+	// Extra row means there is next page.
+	var next *models.Cursor
+	if limit > 0 && len(tenders) > int(limit) {
+		tenders = tenders[:limit]
+		last := tenders[limit-1]
+		next = &models.Cursor{Rank: lastRank, Name: last.Name, CreatedAt: last.CreatedAt, Id: last.Id}
+	}
+
+	return slices.Clip(tenders), next, nil
 }
 
-// UserTenders returns tenders related to user.
-func (s *Storage) UserTenders(ctx context.Context, limit, offset int32, username string) ([]models.Tender, error) {
+// UserTenders returns tenders of organizations user is responsible for
+// in alphabet order.
+// If statuses are given, only tenders in one of them are returned.
+// Page starts after given cursor, cursor of the next page is returned if there is one.
+func (s *Storage) UserTenders(ctx context.Context, limit, offset int32, after *models.Cursor, username string, statuses []models.TenderStatus) ([]models.Tender, *models.Cursor, error) {
 	const op = "storage.Postgres.UserTenders"
 
 	// Get worker
@@ -181,50 +270,76 @@ func (s *Storage) UserTenders(ctx context.Context, limit, offset int32, username
 	if w = s.tx(ctx); w == nil {
 		conn, err := s.conn(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 		defer conn.Release()
 		w = conn
 	}
 
+	filter := make([]string, 0, len(statuses))
+	for _, st := range statuses {
+		filter = append(filter, string(st))
+	}
+
+	// Offset is ignored if page starts after cursor.
+	var cur models.Cursor
+	if after != nil {
+		cur = *after
+		offset = 0
+	}
+
+	// One extra row is fetched to find out if there is next page.
 	rows, err := w.Query(ctx, `
-		SELECT id, organization_id, name, description, type, status, version, created_at
+		SELECT id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements
 		FROM tender
-		WHERE organization_id=(
-			SELECT id from employee
-			WHERE username=$1
-		)
-		ORDER BY name ASC
-		LIMIT $2
+		WHERE
+			organization_id IN (
+				SELECT r.organization_id
+				FROM organization_responsible r
+				JOIN employee e ON e.id = r.user_id
+				WHERE e.username=$1
+			)
+			AND (cardinality($4::text[]) = 0 OR status::text = ANY($4::text[]))
+			AND (NOT $5::boolean OR (name, id) > ($6, $7))
+		ORDER BY name ASC, id ASC
+		LIMIT $2::int + 1
 		OFFSET $3
-	`, username, limit, offset)
+	`, username, limit, offset, filter, after != nil, cur.Name, cur.Id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, storage.ErrTenderNotFound
+			return nil, nil, storage.ErrTenderNotFound
 		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 		}
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	var tender models.Tender
-	tenders := make([]models.Tender, 0, limit)
+	tenders := make([]models.Tender, 0, limit+1)
 
 	for rows.Next() {
-		if err := rows.Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt); err != nil {
+		if err := rows.Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt, &tender.Deadline, &tender.Budget, &tender.Currency, &tender.Quantity, &tender.Unit, &tender.Location, &tender.Requirements); err != nil {
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) {
-				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+				return nil, nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 			}
-			return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
 		}
 
 		tenders = append(tenders, tender)
 	}
 
-	return slices.Clip(tenders), nil
+	// Extra row means there is next page.
+	var next *models.Cursor
+	if limit > 0 && len(tenders) > int(limit) {
+		tenders = tenders[:limit]
+		last := tenders[limit-1]
+		next = &models.Cursor{Name: last.Name, Id: last.Id}
+	}
+
+	return slices.Clip(tenders), next, nil
 }
 
 // TenderSetStatus updates tender status.
@@ -248,9 +363,9 @@ func (s *Storage) TenderSetStatus(ctx context.Context, tenderId uuid.UUID, statu
 		UPDATE tender
 		SET status=$2
 		WHERE id=$1
-		RETURNING id, organization_id, name, description, type, status, version, created_at
+		RETURNING id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements
 	`, tenderId, status).
-		Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt); err != nil {
+		Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt, &tender.Deadline, &tender.Budget, &tender.Currency, &tender.Quantity, &tender.Unit, &tender.Location, &tender.Requirements); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Tender{}, storage.ErrTenderNotFound
 		}
@@ -263,3 +378,51 @@ func (s *Storage) TenderSetStatus(ctx context.Context, tenderId uuid.UUID, statu
 
 	return tender, nil
 }
+
+// CloseOverdueTenders closes published tenders whose deadline has passed,
+// returns closed tenders.
+func (s *Storage) CloseOverdueTenders(ctx context.Context) ([]models.Tender, error) {
+	const op = "storage.Postgres.CloseOverdueTenders"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	rows, err := w.Query(ctx, `
+		UPDATE tender
+		SET status='Closed'
+		WHERE status='Published' AND deadline IS NOT NULL AND deadline <= CURRENT_TIMESTAMP
+		RETURNING id, organization_id, name, description, type, status, version, created_at, deadline, budget, currency, quantity, unit, location, requirements
+	`)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var tender models.Tender
+	var tenders []models.Tender
+
+	for rows.Next() {
+		if err := rows.Scan(&tender.Id, &tender.OrgId, &tender.Name, &tender.Desc, &tender.ServiceType, &tender.Status, &tender.Version, &tender.CreatedAt, &tender.Deadline, &tender.Budget, &tender.Currency, &tender.Quantity, &tender.Unit, &tender.Location, &tender.Requirements); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) {
+				return nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			}
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		tenders = append(tenders, tender)
+	}
+
+	return tenders, nil
+}
@@ -13,7 +13,8 @@ import (
 type txKey string
 
 const (
-	Begin txKey = "storage.Postgres.tx"
+	Begin  txKey = "storage.Postgres.tx"
+	nested txKey = "storage.Postgres.nested"
 )
 
 type worker interface {
@@ -26,8 +27,10 @@ type worker interface {
 func (s *Storage) Begin(ctx context.Context) (context.Context, error) {
 	const op = "storage.Postgres.Begin"
 
+	// Transaction is owned by outer call,
+	// it will be committed or rolled back there.
 	if s.tx(ctx) != nil {
-		return ctx, nil
+		return context.WithValue(ctx, nested, true), nil
 	}
 
 	tx, err := s.pool.Begin(ctx)
@@ -48,6 +51,10 @@ func (s *Storage) Begin(ctx context.Context) (context.Context, error) {
 func (s *Storage) Commit(ctx context.Context) error {
 	const op = "storage.Postgres.Commit"
 
+	if s.nested(ctx) {
+		return nil
+	}
+
 	tx := s.tx(ctx)
 
 	if err := tx.Commit(ctx); err != nil {
@@ -65,6 +72,10 @@ func (s *Storage) Commit(ctx context.Context) error {
 func (s *Storage) Rollback(ctx context.Context) error {
 	const op = "storage.Postgres.Rollback"
 
+	if s.nested(ctx) {
+		return nil
+	}
+
 	tx := s.tx(ctx)
 
 	if err := tx.Rollback(ctx); err != nil {
@@ -104,6 +115,13 @@ func (s *Storage) tx(ctx context.Context) pgx.Tx {
 	return tx
 }
 
+// nested checks if tx saved in context
+// was started by outer call.
+func (s *Storage) nested(ctx context.Context) bool {
+	val, _ := ctx.Value(nested).(bool)
+	return val
+}
+
 // conn returns new conn
 func (s *Storage) conn(ctx context.Context) (*pgxpool.Conn, error) {
 	const op = "storage.Postgres.conn"
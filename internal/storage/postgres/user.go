@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"tender/internal/models"
 	"tender/internal/storage"
 
 	"github.com/google/uuid"
@@ -28,7 +29,7 @@ func (s *Storage) VerifyUser(ctx context.Context, username string) (bool, error)
 
 	var exists bool
 
-	if err := w.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM employee WHERE username=$1)", username).Scan(&exists); err != nil {
+	if err := w.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM employee WHERE username=$1 AND deleted_at IS NULL)", username).Scan(&exists); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return false, nil
 		}
@@ -59,7 +60,7 @@ func (s *Storage) VerifyUserId(ctx context.Context, userId uuid.UUID) (bool, err
 
 	var exists bool
 
-	if err := w.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM employee WHERE id=$1)", userId).Scan(&exists); err != nil {
+	if err := w.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM employee WHERE id=$1 AND deleted_at IS NULL)", userId).Scan(&exists); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return false, nil
 		}
@@ -121,7 +122,7 @@ func (s *Storage) UserId(ctx context.Context, username string) (uuid.UUID, error
 
 	var id uuid.UUID
 
-	if err := w.QueryRow(ctx, "SELECT id from employee where username=$1", username).Scan(&id); err != nil {
+	if err := w.QueryRow(ctx, "SELECT id from employee where username=$1 AND deleted_at IS NULL", username).Scan(&id); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			return uuid.Nil, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
@@ -132,49 +133,44 @@ func (s *Storage) UserId(ctx context.Context, username string) (uuid.UUID, error
 	return id, nil
 }
 
-// VerifyUserPermission check if username is related to organization.
-func (s *Storage) VerifyUserPermission(ctx context.Context, username string, orgId uuid.UUID) (bool, error) {
-	const op = "storage.Postgres.VerifyUserPermission"
+// UserRole returns user's role in organization.
+func (s *Storage) UserRole(ctx context.Context, username string, orgId uuid.UUID) (models.Role, error) {
+	const op = "storage.Postgres.UserRole"
 
 	// Get worker
 	var w worker
 	if w = s.tx(ctx); w == nil {
 		conn, err := s.conn(ctx)
 		if err != nil {
-			return false, fmt.Errorf("%s: %w", op, err)
+			return "", fmt.Errorf("%s: %w", op, err)
 		}
 		defer conn.Release()
 		w = conn
 	}
 
-	var exists bool
+	var role models.Role
 
 	if err := w.QueryRow(ctx, `
-		SELECT EXISTS(
-			SELECT 1
-			FROM organization_responsible
-			WHERE organization_id=$1 AND
-				user_id=(
-					SELECT id from employee
-					WHERE username=$2
-				)
-		)
-		`, orgId, username).Scan(&exists); err != nil {
+		SELECT r.role
+		FROM organization_responsible r
+		JOIN employee e ON e.id = r.user_id
+		WHERE r.organization_id=$1 AND e.username=$2
+	`, orgId, username).Scan(&role); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return false, nil
+			return "", storage.ErrNotResponsible
 		}
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
-			return false, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+			return "", fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
 		}
-		return false, fmt.Errorf("%s: %w", op, err)
+		return "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	return exists, nil
+	return role, nil
 }
 
-// OrgSize returns # of org employees.
-func (s *Storage) OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error) {
+// OrgSize returns # of org employees having one of roles.
+func (s *Storage) OrgSize(ctx context.Context, orgId uuid.UUID, roles []models.Role) (int64, error) {
 	const op = "storage.Postgres.OrgSize"
 
 	// Get worker
@@ -190,13 +186,18 @@ func (s *Storage) OrgSize(ctx context.Context, orgId uuid.UUID) (int64, error) {
 
 	var size int64
 
+	roleNames := make([]string, 0, len(roles))
+	for _, role := range roles {
+		roleNames = append(roleNames, string(role))
+	}
+
 	if err := w.QueryRow(ctx, `
 		SELECT COUNT(*)
 		FROM employee e
 		JOIN organization_responsible r ON e.id = r.user_id
 		JOIN organization o ON o.id = r.organization_id
-		WHERE o.id = $1
-	`, orgId).
+		WHERE o.id = $1 AND r.role::text = ANY($2)
+	`, orgId, roleNames).
 		Scan(&size); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return 0, storage.ErrOrgNotFound
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tender/internal/models"
+	"tender/internal/storage"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// InsertWithdrawal saves bid's withdrawal.
+func (s *Storage) InsertWithdrawal(ctx context.Context, withdrawal models.Withdrawal) (models.Withdrawal, error) {
+	const op = "storage.Postgres.InsertWithdrawal"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Withdrawal{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	if err := w.QueryRow(ctx, `
+		INSERT INTO bid_withdrawal(bid_id, user_id, reason)
+		VALUES($1, $2, $3)
+		RETURNING id, withdrawn_at
+	`, withdrawal.BidId, withdrawal.UserId, withdrawal.Reason).
+		Scan(&withdrawal.Id, &withdrawal.WithdrawnAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Withdrawal{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Withdrawal{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return withdrawal, nil
+}
+
+// ResubmitWithdrawal marks bid's pending withdrawal as resubmitted.
+// Returns ErrWithdrawalNotFound if bid has no pending withdrawal.
+func (s *Storage) ResubmitWithdrawal(ctx context.Context, bidId uuid.UUID) (models.Withdrawal, error) {
+	const op = "storage.Postgres.ResubmitWithdrawal"
+
+	// Get worker
+	var w worker
+	if w = s.tx(ctx); w == nil {
+		conn, err := s.conn(ctx)
+		if err != nil {
+			return models.Withdrawal{}, fmt.Errorf("%s: %w", op, err)
+		}
+		defer conn.Release()
+		w = conn
+	}
+
+	var withdrawal models.Withdrawal
+
+	if err := w.QueryRow(ctx, `
+		UPDATE bid_withdrawal
+		SET resubmitted_at=CURRENT_TIMESTAMP
+		WHERE bid_id=$1 AND resubmitted_at IS NULL
+		RETURNING id, bid_id, user_id, reason, withdrawn_at, resubmitted_at
+	`, bidId).
+		Scan(&withdrawal.Id, &withdrawal.BidId, &withdrawal.UserId, &withdrawal.Reason, &withdrawal.WithdrawnAt, &withdrawal.ResubmittedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Withdrawal{}, storage.ErrWithdrawalNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			return models.Withdrawal{}, fmt.Errorf("%s pgx error: [%s] %s", op, pgErr.Code, pgErr.Message)
+		}
+		return models.Withdrawal{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return withdrawal, nil
+}
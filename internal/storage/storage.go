@@ -5,8 +5,15 @@ import (
 )
 
 var (
-	ErrOrgNotFound     = errors.New("org not found")
-	ErrTenderNotFound  = errors.New("tender not found")
-	ErrBidNotFound     = errors.New("bid not found")
-	ErrVersionNotFound = errors.New("version not found")
+	ErrOrgNotFound        = errors.New("org not found")
+	ErrNotResponsible     = errors.New("user is not responsible")
+	ErrEmployeeNotFound   = errors.New("employee not found")
+	ErrUsernameTaken      = errors.New("username is taken")
+	ErrAlreadyResponsible = errors.New("user is already responsible")
+	ErrTenderNotFound     = errors.New("tender not found")
+	ErrBidNotFound        = errors.New("bid not found")
+	ErrVersionNotFound    = errors.New("version not found")
+	ErrVersionConflict    = errors.New("version conflict")
+	ErrWithdrawalNotFound = errors.New("withdrawal not found")
+	ErrQuestionNotFound   = errors.New("question not found")
 )
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS tender_published_deadline_idx;
+
+ALTER TABLE rollback_tender DROP COLUMN IF EXISTS deadline;
+ALTER TABLE tender DROP COLUMN IF EXISTS deadline;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS deadline TIMESTAMPTZ;
+ALTER TABLE rollback_tender ADD COLUMN IF NOT EXISTS deadline TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS tender_published_deadline_idx
+    ON tender(deadline)
+    WHERE status = 'Published' AND deadline IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS bid_search_idx;
+DROP INDEX IF EXISTS tender_search_idx;
+
+ALTER TABLE bid DROP COLUMN IF EXISTS search;
+ALTER TABLE tender DROP COLUMN IF EXISTS search;
+
+COMMIT;
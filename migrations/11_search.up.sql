@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE tender ADD COLUMN IF NOT EXISTS search TSVECTOR
+    GENERATED ALWAYS AS (
+        to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(description, ''))
+    ) STORED;
+ALTER TABLE bid ADD COLUMN IF NOT EXISTS search TSVECTOR
+    GENERATED ALWAYS AS (
+        to_tsvector('simple', coalesce(name, '') || ' ' || coalesce(description, ''))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS tender_search_idx ON tender USING GIN(search);
+CREATE INDEX IF NOT EXISTS bid_search_idx ON bid USING GIN(search);
+
+COMMIT;
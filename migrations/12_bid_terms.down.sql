@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE rollback_bid
+    DROP COLUMN IF EXISTS warranty_months,
+    DROP COLUMN IF EXISTS delivery_days,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS price;
+
+ALTER TABLE bid
+    DROP COLUMN IF EXISTS warranty_months,
+    DROP COLUMN IF EXISTS delivery_days,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS price;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE bid
+    ADD COLUMN IF NOT EXISTS price BIGINT CHECK (price >= 0),
+    ADD COLUMN IF NOT EXISTS currency CHAR(3),
+    ADD COLUMN IF NOT EXISTS delivery_days INTEGER CHECK (delivery_days >= 0),
+    ADD COLUMN IF NOT EXISTS warranty_months INTEGER CHECK (warranty_months >= 0);
+
+ALTER TABLE rollback_bid
+    ADD COLUMN IF NOT EXISTS price BIGINT,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3),
+    ADD COLUMN IF NOT EXISTS delivery_days INTEGER,
+    ADD COLUMN IF NOT EXISTS warranty_months INTEGER;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE rollback_tender
+    DROP COLUMN IF EXISTS requirements,
+    DROP COLUMN IF EXISTS location,
+    DROP COLUMN IF EXISTS unit,
+    DROP COLUMN IF EXISTS quantity,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS budget;
+
+ALTER TABLE tender
+    DROP COLUMN IF EXISTS requirements,
+    DROP COLUMN IF EXISTS location,
+    DROP COLUMN IF EXISTS unit,
+    DROP COLUMN IF EXISTS quantity,
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS budget;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE tender
+    ADD COLUMN IF NOT EXISTS budget BIGINT CHECK (budget >= 0),
+    ADD COLUMN IF NOT EXISTS currency CHAR(3),
+    ADD COLUMN IF NOT EXISTS quantity DOUBLE PRECISION CHECK (quantity > 0),
+    ADD COLUMN IF NOT EXISTS unit VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS location VARCHAR(200),
+    ADD COLUMN IF NOT EXISTS requirements JSONB;
+
+ALTER TABLE rollback_tender
+    ADD COLUMN IF NOT EXISTS budget BIGINT,
+    ADD COLUMN IF NOT EXISTS currency CHAR(3),
+    ADD COLUMN IF NOT EXISTS quantity DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS unit VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS location VARCHAR(200),
+    ADD COLUMN IF NOT EXISTS requirements JSONB;
+
+COMMIT;
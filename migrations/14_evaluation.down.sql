@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS score;
+DROP TABLE IF EXISTS criterion;
+DROP TYPE IF EXISTS criterion_type;
+
+COMMIT;
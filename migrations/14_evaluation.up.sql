@@ -0,0 +1,37 @@
+BEGIN;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 
+        FROM pg_type 
+        WHERE typname = 'criterion_type'
+    ) THEN
+        CREATE TYPE criterion_type AS ENUM (
+            'Price',
+            'Quality',
+            'Delivery',
+            'Other'
+        );
+    END IF;
+END $$;
+
+CREATE TABLE IF NOT EXISTS criterion(
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tender_id UUID REFERENCES tender(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    type criterion_type NOT NULL,
+    weight INTEGER NOT NULL CHECK (weight BETWEEN 1 AND 100),
+    UNIQUE(tender_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS score(
+    user_id UUID REFERENCES employee(id) ON DELETE CASCADE,
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    criterion_id UUID REFERENCES criterion(id) ON DELETE CASCADE,
+    score INTEGER NOT NULL CHECK (score BETWEEN 0 AND 10),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(user_id, bid_id, criterion_id)
+);
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE organization
+    DROP COLUMN IF EXISTS quorum_veto,
+    DROP COLUMN IF EXISTS quorum_value,
+    DROP COLUMN IF EXISTS quorum_type;
+
+DROP TYPE IF EXISTS quorum_type;
+
+COMMIT;
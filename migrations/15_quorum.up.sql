@@ -0,0 +1,24 @@
+BEGIN;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 
+        FROM pg_type 
+        WHERE typname = 'quorum_type'
+    ) THEN
+        CREATE TYPE quorum_type AS ENUM (
+            'count',
+            'percent',
+            'unanimous'
+        );
+    END IF;
+END $$;
+
+-- Defaults keep former policy: 3 approvals, any rejection is a veto.
+ALTER TABLE organization
+    ADD COLUMN IF NOT EXISTS quorum_type quorum_type NOT NULL DEFAULT 'count',
+    ADD COLUMN IF NOT EXISTS quorum_value INTEGER NOT NULL DEFAULT 3,
+    ADD COLUMN IF NOT EXISTS quorum_veto BOOLEAN NOT NULL DEFAULT TRUE;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+DROP INDEX IF EXISTS decision_bid_idx;
+
+-- Keep only the latest decision of each user.
+DELETE FROM decision d
+USING decision newer
+WHERE
+    newer.user_id = d.user_id
+    AND newer.bid_id = d.bid_id
+    AND (newer.created_at, newer.id) > (d.created_at, d.id);
+
+ALTER TABLE decision DROP CONSTRAINT IF EXISTS decision_pkey;
+
+ALTER TABLE decision
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS bid_version,
+    DROP COLUMN IF EXISTS comment,
+    DROP COLUMN IF EXISTS id;
+
+ALTER TABLE decision ADD PRIMARY KEY (user_id, bid_id);
+
+COMMIT;
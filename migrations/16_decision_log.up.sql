@@ -0,0 +1,21 @@
+BEGIN;
+
+-- Decisions become append-only log, the latest decision of user counts.
+ALTER TABLE decision DROP CONSTRAINT IF EXISTS decision_pkey;
+
+ALTER TABLE decision
+    ADD COLUMN IF NOT EXISTS id UUID DEFAULT uuid_generate_v4(),
+    ADD COLUMN IF NOT EXISTS comment VARCHAR(1000),
+    ADD COLUMN IF NOT EXISTS bid_version INTEGER,
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+
+UPDATE decision d
+SET bid_version = b.version
+FROM bid b
+WHERE b.id = d.bid_id AND d.bid_version IS NULL;
+
+ALTER TABLE decision ADD PRIMARY KEY (id);
+
+CREATE INDEX IF NOT EXISTS decision_bid_idx ON decision(bid_id, created_at);
+
+COMMIT;
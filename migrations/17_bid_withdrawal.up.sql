@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS bid_withdrawal(
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID REFERENCES bid(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES employee(id) ON DELETE CASCADE,
+    reason VARCHAR(1000) NOT NULL,
+    withdrawn_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    resubmitted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS bid_withdrawal_bid_idx ON bid_withdrawal(bid_id, withdrawn_at);
+
+COMMIT;
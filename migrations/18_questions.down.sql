@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS answer;
+DROP TABLE IF EXISTS question;
+
+COMMIT;
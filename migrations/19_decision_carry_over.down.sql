@@ -0,0 +1,7 @@
+BEGIN;
+
+DELETE FROM decision WHERE carried_from_version IS NOT NULL;
+
+ALTER TABLE decision DROP COLUMN IF EXISTS carried_from_version;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Decisions carried over to new version of bid keep
+-- version they were originally made on.
+ALTER TABLE decision ADD COLUMN IF NOT EXISTS carried_from_version INTEGER;
+
+COMMIT;
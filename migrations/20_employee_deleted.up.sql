@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Deleted employees are anonymised instead of removed,
+-- so their decisions, withdrawals and questions are kept.
+ALTER TABLE employee ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+COMMIT;
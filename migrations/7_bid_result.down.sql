@@ -0,0 +1,19 @@
+BEGIN;
+
+UPDATE bid SET status='Canceled' WHERE status IN ('Approved', 'Rejected');
+UPDATE rollback_bid SET status='Canceled' WHERE status IN ('Approved', 'Rejected');
+
+ALTER TYPE bid_status_type RENAME TO bid_status_type_old;
+
+CREATE TYPE bid_status_type AS ENUM(
+    'Created',
+    'Published',
+    'Canceled'
+);
+
+ALTER TABLE bid ALTER COLUMN status TYPE bid_status_type USING status::text::bid_status_type;
+ALTER TABLE rollback_bid ALTER COLUMN status TYPE bid_status_type USING status::text::bid_status_type;
+
+DROP TYPE bid_status_type_old;
+
+COMMIT;
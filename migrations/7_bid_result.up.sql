@@ -0,0 +1,2 @@
+ALTER TYPE bid_status_type ADD VALUE IF NOT EXISTS 'Approved';
+ALTER TYPE bid_status_type ADD VALUE IF NOT EXISTS 'Rejected';
@@ -0,0 +1 @@
+ALTER TYPE bid_status_type ADD VALUE IF NOT EXISTS 'Withdrawn';
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE organization_responsible DROP COLUMN IF EXISTS role;
+DROP TYPE IF EXISTS responsible_role_type;
+
+COMMIT;
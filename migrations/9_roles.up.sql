@@ -0,0 +1,23 @@
+BEGIN;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 
+        FROM pg_type 
+        WHERE typname = 'responsible_role_type'
+    ) THEN
+        CREATE TYPE responsible_role_type AS ENUM (
+            'viewer',
+            'editor',
+            'approver',
+            'org-admin'
+        );
+    END IF;
+END $$;
+
+-- Existing responsibles keep all their privileges.
+ALTER TABLE organization_responsible
+    ADD COLUMN IF NOT EXISTS role responsible_role_type NOT NULL DEFAULT 'org-admin';
+
+COMMIT;